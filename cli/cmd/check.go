@@ -6,15 +6,22 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/reviewpad/reviewpad/v3"
+	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
+	"github.com/reviewpad/reviewpad/v3/engine"
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	rootCmd.AddCommand(checkCmd)
+	checkCmd.Flags().StringVarP(&gitHubToken, "github-token", "t", "", "GitHub personal access token, required to check files with git imports")
 }
 
 var checkCmd = &cobra.Command{
@@ -26,48 +33,30 @@ var checkCmd = &cobra.Command{
 			return err
 		}
 
+		ctx := context.Background()
+
+		// Without a token, the file cannot have git imports.
+		var githubClient *gh.GithubClient
+		if gitHubToken != "" {
+			githubClient = gh.NewGithubClientFromToken(ctx, gitHubToken)
+		}
+
 		buf := bytes.NewBuffer(data)
-		reviewpadFile, err := reviewpad.Load(buf)
+		reviewpadFile, err := reviewpad.LoadWithOptions(ctx, buf, engine.LoadOptions{Dir: filepath.Dir(reviewpadFile), GithubClient: githubClient})
 		if err != nil {
 			return err
 		}
 
-		for _, group := range reviewpadFile.Groups {
-			if group.Spec != "" {
-				if _, err = aladino.Parse(group.Spec); err != nil {
-					return err
-				}
-			}
+		// The check only needs the types of the built-ins, so no plugin services are configured.
+		builtIns := plugins_aladino.PluginBuiltInsWithConfig(&plugins_aladino.PluginConfig{})
 
-			if group.Where != "" {
-				if _, err = aladino.Parse(group.Where); err != nil {
-					return err
-				}
-			}
+		errs := aladino.CheckFile(reviewpadFile, builtIns)
+		for _, err := range errs {
+			cmd.PrintErrln(err)
 		}
 
-		for _, rules := range reviewpadFile.Rules {
-			if rules.Spec != "" {
-				if _, err = aladino.Parse(rules.Spec); err != nil {
-					return err
-				}
-			}
-		}
-
-		for _, workflow := range reviewpadFile.Workflows {
-			for _, rule := range workflow.Rules {
-				for _, action := range rule.ExtraActions {
-					if _, err = aladino.Parse(action); err != nil {
-						return err
-					}
-				}
-			}
-
-			for _, action := range workflow.Actions {
-				if _, err = aladino.Parse(action); err != nil {
-					return err
-				}
-			}
+		if len(errs) > 0 {
+			return fmt.Errorf("found %v errors", len(errs))
 		}
 
 		return nil
@@ -0,0 +1,68 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+	explainCmd.Flags().StringVarP(&ruleName, "rule", "r", "", "Name of the rule to explain")
+	explainCmd.Flags().StringVarP(&pullRequestUrl, "pull-request", "p", "", "GitHub pull request url")
+	explainCmd.Flags().StringVarP(&gitHubToken, "github-token", "t", "", "GitHub personal access token")
+	explainCmd.Flags().StringVarP(&fixtureFile, "fixture", "x", "", "File path to a recorded pull request in JSON format")
+	explainCmd.MarkFlagRequired("rule")
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Explains why a rule is activated or not on a pull request",
+	Long: `Evaluates a rule of the reviewpad file against a pull request, given by its url or recorded in a fixture,
+and shows the value of each of its sub-expressions and the calls to the built-ins.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fixtureFile == "" && (pullRequestUrl == "" || gitHubToken == "") {
+			return fmt.Errorf("either a fixture or a pull request and a GitHub token are required")
+		}
+
+		config, err := plugins_aladino.DefaultPluginConfig()
+		if err != nil {
+			return err
+		}
+
+		defer config.CleanupPluginConfig()
+
+		env, err := buildDryRunEnv(plugins_aladino.PluginBuiltInsWithConfig(config))
+		if err != nil {
+			return err
+		}
+
+		spec, ok := env.GetRegisterMap()[aladino.BuildInternalRuleName(ruleName)].(*aladino.StringValue)
+		if !ok {
+			return fmt.Errorf("no rule with name %v in the reviewpad file", ruleName)
+		}
+
+		activated, trace, err := aladino.Explain(env, spec.Val)
+		if trace != nil {
+			fmt.Print(trace.String())
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if activated {
+			fmt.Printf("rule %v is activated\n", ruleName)
+		} else {
+			fmt.Printf("rule %v is not activated\n", ruleName)
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/google/go-github/v45/github"
+	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
+	"github.com/shurcooL/githubv4"
+)
+
+// Fixture is a recording of a pull request and of the answers of the GitHub API about it.
+// The responses are indexed by the method and the path of the request, e.g. "GET /repos/reviewpad/reviewpad/pulls/1/files".
+// Requests to the GraphQL API are indexed by "POST /graphql".
+// See cli/testdata/test_pull_request_fixture.json for an example.
+type Fixture struct {
+	PullRequest *github.PullRequest        `json:"pull_request"`
+	Responses   map[string]json.RawMessage `json:"responses"`
+}
+
+func loadFixture(path string) (*Fixture, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixture := &Fixture{}
+	err = json.Unmarshal(content, fixture)
+	if err != nil {
+		return nil, fmt.Errorf("error reading fixture %v. Details: %v", path, err.Error())
+	}
+
+	if fixture.PullRequest == nil {
+		return nil, fmt.Errorf("error reading fixture %v. Details: the pull request is missing", path)
+	}
+
+	return fixture, nil
+}
+
+// githubClient returns a client that answers with the recorded responses, without access to the network.
+func (f *Fixture) githubClient() *gh.GithubClient {
+	httpClient := &http.Client{
+		Transport: &replayTransport{responses: f.Responses},
+	}
+
+	return gh.NewGithubClient(github.NewClient(httpClient), githubv4.NewClient(httpClient))
+}
+
+// replayTransport answers every request with the response recorded for it.
+// The requests without a recorded response fail with a not found error.
+type replayTransport struct {
+	responses map[string]json.RawMessage
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := fmt.Sprintf("%v %v", req.Method, req.URL.Path)
+
+	status := http.StatusOK
+	body, ok := t.responses[key]
+	if !ok {
+		status = http.StatusNotFound
+		body, _ = json.Marshal(map[string]string{"message": fmt.Sprintf("no recorded response for %v", key)})
+	}
+
+	return &http.Response{
+		Status:     http.StatusText(status),
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
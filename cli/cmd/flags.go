@@ -7,9 +7,11 @@ package cmd
 var (
 	dryRun         bool
 	eventFilePath  string
+	fixtureFile    string
 	gitHubToken    string
 	mixpanelToken  string
 	pullRequestUrl string
 	reviewpadFile  string
+	ruleName       string
 	safeModeRun    bool
 )
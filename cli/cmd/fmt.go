@@ -0,0 +1,46 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(fmtCmd)
+}
+
+var fmtCmd = &cobra.Command{
+	Use:   "fmt",
+	Short: "Formats the reviewpad file",
+	Long: `Rewrites the reviewpad file with its expressions in canonical form, its labels sorted by key
+and an indentation of two spaces. The comments of the file are kept.
+The expressions with comments are left as written and reported, since formatting them would drop the comments.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info, err := os.Stat(reviewpadFile)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(reviewpadFile)
+		if err != nil {
+			return err
+		}
+
+		formatted, unformatted, err := aladino.FormatFile(data)
+		if err != nil {
+			return err
+		}
+
+		for _, path := range unformatted {
+			cmd.PrintErrf("warning: %v was left as written since it has comments\n", path)
+		}
+
+		return os.WriteFile(reviewpadFile, formatted, info.Mode())
+	},
+}
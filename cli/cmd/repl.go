@@ -0,0 +1,287 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/reviewpad/reviewpad/v3"
+	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
+	"github.com/reviewpad/reviewpad/v3/collector"
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const replPrompt = "aladino> "
+
+const replHelp = `Type an expression to see its value and its type, e.g. $size() > 10.
+Actions are type checked but not executed.
+Press tab to complete the names of the built-ins, rules and groups.
+
+Commands:
+  :help  show this message
+  :quit  exit the repl`
+
+func init() {
+	rootCmd.AddCommand(replCmd)
+	replCmd.Flags().StringVarP(&pullRequestUrl, "pull-request", "p", "", "GitHub pull request url")
+	replCmd.Flags().StringVarP(&gitHubToken, "github-token", "t", "", "GitHub personal access token")
+	replCmd.Flags().StringVarP(&fixtureFile, "fixture", "x", "", "File path to a recorded pull request in JSON format")
+}
+
+var replCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "Evaluates Aladino expressions against a pull request",
+	Long: `Evaluates Aladino expressions against a pull request, given by its url or recorded in a fixture.
+The constants, functions, groups and rules of the reviewpad file can be used in the expressions.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fixtureFile == "" && (pullRequestUrl == "" || gitHubToken == "") {
+			return fmt.Errorf("either a fixture or a pull request and a GitHub token are required")
+		}
+
+		config, err := plugins_aladino.DefaultPluginConfig()
+		if err != nil {
+			return err
+		}
+
+		defer config.CleanupPluginConfig()
+
+		env, err := buildDryRunEnv(plugins_aladino.PluginBuiltInsWithConfig(config))
+		if err != nil {
+			return err
+		}
+
+		return repl(env)
+	},
+}
+
+// buildDryRunEnv builds the environment where the expressions of the repl and explain commands are evaluated.
+// Actions are never executed in this environment.
+func buildDryRunEnv(builtIns *aladino.BuiltIns) (aladino.Env, error) {
+	ctx := context.Background()
+
+	var githubClient *gh.GithubClient
+	var pullRequest *github.PullRequest
+
+	if fixtureFile != "" {
+		fixture, err := loadFixture(fixtureFile)
+		if err != nil {
+			return nil, err
+		}
+
+		githubClient = fixture.githubClient()
+		pullRequest = fixture.PullRequest
+	} else {
+		repositoryOwner, repositoryName, pullRequestNumber, err := parsePullRequestUrl(pullRequestUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		githubClient = gh.NewGithubClientFromToken(ctx, gitHubToken)
+
+		pullRequest, _, err = githubClient.GetPullRequest(ctx, repositoryOwner, repositoryName, pullRequestNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Without a token, nothing is collected.
+	collectorClient := collector.NewCollector("", gh.GetPullRequestBaseOwnerName(pullRequest))
+
+	interpreter, err := aladino.NewInterpreter(ctx, true, githubClient, collectorClient, pullRequest, nil, builtIns)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(reviewpadFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading reviewpad file. Details: %v", err.Error())
+	}
+
+	file, err := reviewpad.LoadWithOptions(ctx, bytes.NewBuffer(data), engine.LoadOptions{Dir: filepath.Dir(reviewpadFile), GithubClient: githubClient})
+	if err != nil {
+		return nil, err
+	}
+
+	err = processDefinitions(interpreter, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.(*aladino.Interpreter).Env, nil
+}
+
+// processDefinitions makes the constants, functions, labels, groups and rules of the reviewpad file
+// available to the expressions typed in the repl.
+func processDefinitions(interpreter engine.Interpreter, file *engine.ReviewpadFile) error {
+	for _, constant := range file.Constants {
+		err := interpreter.ProcessConstant(constant.Name, constant.Type, constant.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, function := range file.Functions {
+		err := interpreter.ProcessFunction(function.Name, function.Parameters, function.ReturnType, function.Body)
+		if err != nil {
+			return err
+		}
+	}
+
+	for labelKeyName, label := range file.Labels {
+		labelName := labelKeyName
+		if label.Name != "" {
+			labelName = label.Name
+		}
+
+		err := interpreter.ProcessLabel(labelKeyName, labelName)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range file.Groups {
+		err := interpreter.ProcessGroup(group.Name, engine.GroupKind(group.Kind), engine.GroupType(group.Type), group.Spec, group.Param, group.Where)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range file.Rules {
+		err := interpreter.ProcessRule(rule.Name, rule.Spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// repl reads and evaluates expressions until the end of the input.
+// Tab completion is only available when the input is a terminal.
+func repl(env aladino.Env) error {
+	stdin := int(os.Stdin.Fd())
+	if !term.IsTerminal(stdin) {
+		return replLines(env, bufio.NewScanner(os.Stdin), os.Stdout)
+	}
+
+	oldState, err := term.MakeRaw(stdin)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(stdin, oldState)
+
+	terminal := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, replPrompt)
+
+	completer := &replCompleter{env: env, terminal: terminal}
+	terminal.AutoCompleteCallback = completer.complete
+
+	fmt.Fprintln(terminal, "Type :help for help.")
+
+	for {
+		line, err := terminal.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !evalLine(env, line, terminal) {
+			return nil
+		}
+	}
+}
+
+func replLines(env aladino.Env, scanner *bufio.Scanner, out io.Writer) error {
+	for scanner.Scan() {
+		if !evalLine(env, scanner.Text(), out) {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+// evalLine evaluates a line typed in the repl and writes its result.
+// It returns false when the repl should stop.
+func evalLine(env aladino.Env, line string, out io.Writer) bool {
+	line = strings.TrimSpace(line)
+
+	switch line {
+	case "":
+		return true
+	case ":quit", ":q":
+		return false
+	case ":help", ":h":
+		fmt.Fprintln(out, replHelp)
+		return true
+	}
+
+	value, valueType, err := aladino.Inspect(env, line)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		return true
+	}
+
+	if valueType == nil {
+		fmt.Fprintln(out, "action not executed (dry run)")
+		return true
+	}
+
+	fmt.Fprintf(out, "%v : %v\n", aladino.FormatValue(value), aladino.FormatType(valueType))
+	return true
+}
+
+// replCompleter completes the word before the cursor when tab is pressed.
+type replCompleter struct {
+	env      aladino.Env
+	terminal *term.Terminal
+}
+
+func (c *replCompleter) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+
+	start, words := aladino.Complete(c.env, line[:pos])
+	if len(words) == 0 {
+		return line, pos, true
+	}
+
+	completed := line[:start] + commonPrefix(words)
+
+	// Like shells, show the candidates when the word cannot be completed any further.
+	if len(words) > 1 && len(completed) == pos {
+		fmt.Fprintln(c.terminal, strings.Join(words, "  "))
+	}
+
+	return completed + line[pos:], len(completed), true
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
@@ -12,6 +12,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/reviewpad/reviewpad/v3"
 	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
 	"github.com/reviewpad/reviewpad/v3/collector"
+	"github.com/reviewpad/reviewpad/v3/engine"
 	"github.com/spf13/cobra"
 )
 
@@ -51,6 +53,24 @@ func parseEvent(rawEvent string) (interface{}, error) {
 	return github.ParseWebHook(*ev.Name, *ev.Payload)
 }
 
+// parsePullRequestUrl returns the owner, the repository and the number of a pull request from its url.
+func parsePullRequestUrl(url string) (string, string, int, error) {
+	pullRequestDetailsRegex := regexp.MustCompile(`github\.com\/(.+)\/(.+)\/pull\/(\d+)`)
+	pullRequestDetails := pullRequestDetailsRegex.FindSubmatch([]byte(url))
+	if pullRequestDetails == nil {
+		return "", "", 0, fmt.Errorf("invalid pull request url %v", url)
+	}
+
+	repositoryOwner := string(pullRequestDetails[1][:])
+	repositoryName := string(pullRequestDetails[2][:])
+	pullRequestNumber, err := strconv.Atoi(string(pullRequestDetails[3][:]))
+	if err != nil {
+		return "", "", 0, fmt.Errorf("error converting pull request number. Details %+q", err.Error())
+	}
+
+	return repositoryOwner, repositoryName, pullRequestNumber, nil
+}
+
 func run() error {
 	var ev interface{}
 
@@ -69,14 +89,9 @@ func run() error {
 		}
 	}
 
-	pullRequestDetailsRegex := regexp.MustCompile(`github\.com\/(.+)\/(.+)\/pull\/(\d+)`)
-	pullRequestDetails := pullRequestDetailsRegex.FindSubmatch([]byte(pullRequestUrl))
-
-	repositoryOwner := string(pullRequestDetails[1][:])
-	repositoryName := string(pullRequestDetails[2][:])
-	pullRequestNumber, err := strconv.Atoi(string(pullRequestDetails[3][:]))
+	repositoryOwner, repositoryName, pullRequestNumber, err := parsePullRequestUrl(pullRequestUrl)
 	if err != nil {
-		log.Fatalf("Error converting pull request number. Details %+q", err.Error())
+		log.Fatal(err)
 	}
 
 	ctx := context.Background()
@@ -117,7 +132,7 @@ func run() error {
 	}
 
 	buf := bytes.NewBuffer(data)
-	file, err := reviewpad.Load(buf)
+	file, err := reviewpad.LoadWithOptions(ctx, buf, engine.LoadOptions{Dir: filepath.Dir(reviewpadFile), GithubClient: githubClient})
 	if err != nil {
 		return fmt.Errorf("error running reviewpad team edition. Details %v", err.Error())
 	}
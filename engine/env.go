@@ -25,13 +25,21 @@ const ExitStatusSuccess ExitStatus = 0
 const ExitStatusFailure ExitStatus = 1
 
 type Interpreter interface {
+	ProcessConstant(name, typeOf, value string) error
+	ProcessFunction(name string, parameters []PadFunctionParameter, returnType, body string) error
 	ProcessGroup(name string, kind GroupKind, typeOf GroupType, expr, paramExpr, whereExpr string) error
 	ProcessLabel(id, name string) error
 	ProcessRule(name, spec string) error
+	CompileGroup(name string, kind GroupKind, typeOf GroupType, expr, paramExpr, whereExpr string) error
+	CompileExpr(kind, expr string) error
+	CompileStatement(statement string) (CompiledStatement, error)
 	EvalExpr(kind, expr string) (bool, error)
+	ExplainExpr(kind, expr string) (bool, string, error)
 	ExecProgram(program *Program) (ExitStatus, error)
 	ExecStatement(statement *Statement) error
 	Report(mode string, safeMode bool) error
+	ReportExplanation(workflow, explanation string)
+	ReportWarning(message string)
 }
 
 type Env struct {
@@ -0,0 +1,198 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v45/github"
+	"gopkg.in/yaml.v3"
+)
+
+// supportedEvents are the names of the GitHub events that can trigger workflows and pipelines.
+var supportedEvents = []string{
+	"check_run",
+	"check_suite",
+	"issue_comment",
+	"issues",
+	"label",
+	"pull_request",
+	"pull_request_review",
+	"pull_request_review_comment",
+	"pull_request_target",
+	"push",
+	"status",
+	"workflow_run",
+}
+
+// PadEvents maps the name of each GitHub event to its activity types, e.g. opened or synchronize.
+// An event without activity types is matched by any of its activities.
+// In the reviewpad file, the events are written either as a single event name,
+// as a list of event names or as a mapping from event names to their activity types:
+//
+//	on:
+//	  pull_request: [opened, ready_for_review]
+//	  issue_comment:
+type PadEvents map[string][]string
+
+func (p *PadEvents) UnmarshalYAML(value *yaml.Node) error {
+	events := make(PadEvents)
+
+	switch value.Kind {
+	case yaml.ScalarNode:
+		events[value.Value] = nil
+	case yaml.SequenceNode:
+		var names []string
+		err := value.Decode(&names)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			events[name] = nil
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			name, types := value.Content[i].Value, value.Content[i+1]
+
+			switch types.Kind {
+			case yaml.ScalarNode:
+				// an event written without activity types, e.g. `issue_comment:`, has a null value
+				if types.Tag == "!!null" {
+					events[name] = nil
+				} else {
+					events[name] = []string{types.Value}
+				}
+			case yaml.SequenceNode:
+				var activities []string
+				err := types.Decode(&activities)
+				if err != nil {
+					return err
+				}
+
+				events[name] = activities
+			default:
+				return fmt.Errorf("line %v: the activity types of event %v must be a list", types.Line, name)
+			}
+		}
+	default:
+		return fmt.Errorf("line %v: on must be an event, a list of events or a mapping of events to activity types", value.Line)
+	}
+
+	*p = events
+
+	return nil
+}
+
+func (p PadEvents) equals(o PadEvents) bool {
+	if len(p) != len(o) {
+		return false
+	}
+
+	for name, pTypes := range p {
+		oTypes, ok := o[name]
+		if !ok || len(pTypes) != len(oTypes) {
+			return false
+		}
+
+		for i, pType := range pTypes {
+			if pType != oTypes[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// names returns the names of the events in alphabetical order.
+func (p PadEvents) names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// triggeredBy checks if the events include the event with the given name and activity type.
+// No events means that any event is a trigger.
+func (p PadEvents) triggeredBy(name, action string) bool {
+	if len(p) == 0 {
+		return true
+	}
+
+	types, ok := p[name]
+	if !ok {
+		return false
+	}
+
+	if len(types) == 0 {
+		return true
+	}
+
+	for _, t := range types {
+		if t == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventDetails returns the name and the activity type of an event payload parsed by github.ParseWebHook.
+// Events without activity types, such as push, have an empty activity type.
+// The name of an unsupported event is empty.
+func eventDetails(payload interface{}) (string, string) {
+	switch event := payload.(type) {
+	case *github.CheckRunEvent:
+		return "check_run", event.GetAction()
+	case *github.CheckSuiteEvent:
+		return "check_suite", event.GetAction()
+	case *github.IssueCommentEvent:
+		return "issue_comment", event.GetAction()
+	case *github.IssuesEvent:
+		return "issues", event.GetAction()
+	case *github.LabelEvent:
+		return "label", event.GetAction()
+	case *github.PullRequestEvent:
+		return "pull_request", event.GetAction()
+	case *github.PullRequestReviewEvent:
+		return "pull_request_review", event.GetAction()
+	case *github.PullRequestReviewCommentEvent:
+		return "pull_request_review_comment", event.GetAction()
+	case *github.PullRequestTargetEvent:
+		return "pull_request_target", event.GetAction()
+	case *github.PushEvent:
+		return "push", ""
+	case *github.StatusEvent:
+		return "status", ""
+	case *github.WorkflowRunEvent:
+		return "workflow_run", event.GetAction()
+	}
+
+	return "", ""
+}
+
+// isTriggered checks if a workflow or a pipeline with the given events is triggered by the event of the environment.
+// When the environment has no event, e.g. on a dry run, only the workflows and pipelines without events are triggered.
+// It returns the name of the event so that the reason of a skip can be reported.
+func isTriggered(env *Env, events PadEvents) (bool, string) {
+	if len(events) == 0 {
+		return true, ""
+	}
+
+	if env.EventPayload == nil {
+		execLog("\twarning: there is no event to match the events of the workflow or pipeline")
+		return false, "missing"
+	}
+
+	name, action := eventDetails(env.EventPayload)
+
+	return events.triggeredBy(name, action), name
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshalPadEvents(t *testing.T) {
+	tests := map[string]struct {
+		input      string
+		wantEvents PadEvents
+		wantErr    string
+	}{
+		"when events are a single event": {
+			input:      "on: pull_request",
+			wantEvents: PadEvents{"pull_request": nil},
+		},
+		"when events are a list": {
+			input:      "on: [pull_request, issue_comment]",
+			wantEvents: PadEvents{"pull_request": nil, "issue_comment": nil},
+		},
+		"when events have activity types": {
+			input:      "on:\n  pull_request: [opened, ready_for_review]\n  pull_request_review: submitted\n  issue_comment:\n",
+			wantEvents: PadEvents{"pull_request": {"opened", "ready_for_review"}, "pull_request_review": {"submitted"}, "issue_comment": nil},
+		},
+		"when activity types are a mapping": {
+			input:   "on:\n  pull_request:\n    types: [opened]\n",
+			wantErr: "line 3: the activity types of event pull_request must be a list",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotWorkflow PadWorkflow
+			gotErr := yaml.Unmarshal([]byte(test.input), &gotWorkflow)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, gotErr, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, gotErr)
+			assert.Equal(t, test.wantEvents, gotWorkflow.On)
+		})
+	}
+}
+
+func TestTriggeredBy(t *testing.T) {
+	events := PadEvents{"pull_request": {"opened", "ready_for_review"}, "issue_comment": nil}
+
+	assert.True(t, events.triggeredBy("pull_request", "opened"))
+	assert.False(t, events.triggeredBy("pull_request", "synchronize"))
+	assert.True(t, events.triggeredBy("issue_comment", "edited"))
+	assert.False(t, events.triggeredBy("push", ""))
+}
+
+func TestTriggeredBy_WhenThereAreNoEvents(t *testing.T) {
+	assert.True(t, PadEvents{}.triggeredBy("push", ""))
+}
+
+func TestEventDetails(t *testing.T) {
+	tests := map[string]struct {
+		payload    interface{}
+		wantName   string
+		wantAction string
+	}{
+		"when event is a pull request": {
+			payload:    &github.PullRequestEvent{Action: github.String("opened")},
+			wantName:   "pull_request",
+			wantAction: "opened",
+		},
+		"when event is a review": {
+			payload:    &github.PullRequestReviewEvent{Action: github.String("submitted")},
+			wantName:   "pull_request_review",
+			wantAction: "submitted",
+		},
+		"when event has no activity types": {
+			payload:  &github.PushEvent{},
+			wantName: "push",
+		},
+		"when event is not supported": {
+			payload: &github.StarEvent{Action: github.String("created")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotName, gotAction := eventDetails(test.payload)
+
+			assert.Equal(t, test.wantName, gotName)
+			assert.Equal(t, test.wantAction, gotAction)
+		})
+	}
+}
@@ -5,8 +5,10 @@
 package engine
 
 import (
+	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/google/go-github/v45/github"
 	"github.com/reviewpad/reviewpad/v3/utils/fmtio"
@@ -24,6 +26,23 @@ func execLog(val string) {
 	log.Println(fmtio.Sprint("reviewpad", val))
 }
 
+// pathError annotates err with the path, in the reviewpad file, of the expression that caused it.
+func pathError(path string, err error) error {
+	return fmt.Errorf("%v: %w", path, err)
+}
+
+// ignoreError logs and reports as a warning the error of a condition that is treated as false.
+func ignoreError(env *Env, path string, err error) {
+	execLogf("\tignoring error on %v: %v", path, err)
+	env.Interpreter.ReportWarning(fmt.Sprintf("%v failed and was treated as false: %v", path, err))
+}
+
+// skipError logs and reports as a warning the error of a group that is left undefined.
+func skipError(env *Env, path string, err error) {
+	execLogf("\tignoring error on %v: %v", path, err)
+	env.Interpreter.ReportWarning(fmt.Sprintf("%v failed and was skipped: %v", path, err))
+}
+
 func CollectError(env *Env, err error) {
 	var errMsg string
 	ghError, isGitHubError := err.(*github.ErrorResponse)
@@ -56,6 +75,8 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 		"version":        file.Version,
 		"edition":        file.Edition,
 		"mode":           file.Mode,
+		"totalConstants": len(file.Constants),
+		"totalFunctions": len(file.Functions),
 		"totalGroups":    len(file.Groups),
 		"totalLabels":    len(file.Labels),
 		"totalRules":     len(file.Rules),
@@ -64,11 +85,49 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 
 	rules := make(map[string]PadRule)
 
+	execLogf("detected %v constants", len(file.Constants))
+	execLogf("detected %v functions", len(file.Functions))
 	execLogf("detected %v groups", len(file.Groups))
 	execLogf("detected %v labels", len(file.Labels))
 	execLogf("detected %v rules", len(file.Rules))
 	execLogf("detected %v workflows", len(file.Workflows))
 
+	// process constants
+	for i, constant := range file.Constants {
+		err := interpreter.ProcessConstant(constant.Name, constant.Type, constant.Value)
+		if err != nil {
+			err = pathError(fmt.Sprintf("constants[%v]", i), err)
+			CollectError(env, err)
+			return nil, err
+		}
+	}
+
+	// rulePaths keeps the location of each rule spec in the reviewpad file
+	rulePaths := BuildRulePaths(file)
+
+	// compile the functions, the groups, the rules and the actions before running anything with side effects
+	actions, err := compile(file, interpreter, rulePaths)
+	if err != nil {
+		CollectError(env, err)
+		return nil, err
+	}
+
+	// process groups
+	for i, group := range file.Groups {
+		err := interpreter.ProcessGroup(group.Name, GroupKind(group.Kind), GroupType(group.Type), group.Spec, group.Param, group.Where)
+		if err != nil {
+			// a group that fails is left undefined, so the rules that use it fail as well
+			if file.IgnoreErrors {
+				skipError(env, groupPath(i, group), err)
+				continue
+			}
+
+			err = pathError(groupPath(i, group), err)
+			CollectError(env, err)
+			return nil, err
+		}
+	}
+
 	// process labels
 	for labelKeyName, label := range file.Labels {
 		labelName := labelKeyName
@@ -98,15 +157,6 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 		}
 	}
 
-	// process groups
-	for _, group := range file.Groups {
-		err := interpreter.ProcessGroup(group.Name, GroupKind(group.Kind), GroupType(group.Type), group.Spec, group.Param, group.Where)
-		if err != nil {
-			CollectError(env, err)
-			return nil, err
-		}
-	}
-
 	// process rules
 	for _, rule := range file.Rules {
 		err := interpreter.ProcessRule(rule.Name, rule.Spec)
@@ -114,6 +164,7 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 			CollectError(env, err)
 			return nil, err
 		}
+
 		rules[rule.Name] = rule
 	}
 
@@ -121,42 +172,96 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 	program := BuildProgram(make([]*Statement, 0))
 
 	// triggeredExclusiveWorkflow is a control variable to denote if a workflow `always-run: false` has been triggered.
+	// Once it is set, only the workflows with `always-run: true` are evaluated, so the default workflows are skipped.
 	triggeredExclusiveWorkflow := false
 
-	for _, workflow := range file.Workflows {
+	for _, i := range workflowsEvaluationOrder(file.Workflows) {
+		workflow := file.Workflows[i]
 		execLogf("evaluating workflow %v:", workflow.Name)
 
+		// a workflow that is not triggered by the event is skipped before it can prevent other workflows from running
+		triggered, eventName := isTriggered(env, workflow.On)
+		if !triggered {
+			execLogf("\tskipping workflow since it is not triggered by the %v event", eventName)
+			if file.Explain {
+				interpreter.ReportExplanation(workflow.Name, fmt.Sprintf("skipped because it is not triggered by the %v event\n", eventName))
+			}
+			continue
+		}
+
 		if !workflow.AlwaysRun && triggeredExclusiveWorkflow {
 			execLog("\tskipping workflow")
+			if file.Explain {
+				interpreter.ReportExplanation(workflow.Name, "skipped because a workflow without always-run was activated\n")
+			}
 			continue
 		}
 
 		ruleActivatedQueue := make([]PadWorkflowRule, 0)
+		// ruleActivatedIndexes keeps the position in the workflow of each activated rule,
+		// since the same rule can be listed more than once with different actions
+		ruleActivatedIndexes := make([]int, 0)
 		ruleDefinitionQueue := make(map[string]PadRule)
+		ruleExplanations := make([]string, 0)
 
-		for _, rule := range workflow.Rules {
+		for j, rule := range workflow.Rules {
 			ruleName := rule.Rule
 			ruleDefinition := rules[ruleName]
 
-			activated, err := interpreter.EvalExpr(ruleDefinition.Kind, ruleDefinition.Spec)
+			var activated bool
+			var err error
+			if file.Explain {
+				var trace string
+				activated, trace, err = interpreter.ExplainExpr(ruleDefinition.Kind, ruleDefinition.Spec)
+				ruleExplanations = append(ruleExplanations, explainRule(ruleName, trace))
+			} else {
+				activated, err = interpreter.EvalExpr(ruleDefinition.Kind, ruleDefinition.Spec)
+			}
+
 			if err != nil {
-				CollectError(env, err)
-				return nil, err
+				rulePath := rulePaths[ruleName]
+				// inline rules are written directly in the workflow
+				if isInlineRule(ruleName) {
+					rulePath = fmt.Sprintf("workflows[%v].if[%v]", i, j)
+				}
+
+				if !file.IgnoreErrors && !workflow.IgnoreErrors && !ruleDefinition.IgnoreErrors {
+					err = pathError(rulePath, err)
+					CollectError(env, err)
+					return nil, err
+				}
+
+				ignoreError(env, rulePath, err)
+				continue
 			}
 
 			if activated {
 				ruleActivatedQueue = append(ruleActivatedQueue, rule)
+				ruleActivatedIndexes = append(ruleActivatedIndexes, j)
 				ruleDefinitionQueue[ruleName] = ruleDefinition
 
 				execLogf("\trule %v activated", ruleName)
 			}
 		}
 
-		if len(ruleActivatedQueue) > 0 {
-			program.append(workflow.Actions)
+		if file.Explain {
+			explanation := strings.Join(ruleExplanations, "\n")
+			if len(workflow.Rules) == 0 {
+				explanation = "activated since it is a default workflow without rules\n"
+			}
+
+			interpreter.ReportExplanation(workflow.Name, explanation)
+		}
 
-			for _, activatedRule := range ruleActivatedQueue {
-				program.append(activatedRule.ExtraActions)
+		// a default workflow without rules is activated whenever it is evaluated
+		workflowActivated := len(ruleActivatedQueue) > 0 || (workflow.Default && len(workflow.Rules) == 0)
+
+		if workflowActivated {
+			program.append(actions[fmt.Sprintf("workflows[%v].then", i)])
+
+			for _, ruleIndex := range ruleActivatedIndexes {
+				program.append(actions[fmt.Sprintf("workflows[%v].if[%v].then", i, ruleIndex)])
+				program.append(actions[fmt.Sprintf("workflows[%v].if[%v].extra-actions", i, ruleIndex)])
 			}
 
 			if !workflow.AlwaysRun {
@@ -164,38 +269,60 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 			}
 		} else {
 			execLog("\tno rules activated")
+			program.append(actions[fmt.Sprintf("workflows[%v].else", i)])
 		}
 	}
 
-	for _, pipeline := range file.Pipelines {
+	for i, pipeline := range file.Pipelines {
 		execLogf("evaluating pipeline %v:", pipeline.Name)
 
+		triggered, eventName := isTriggered(env, pipeline.On)
+		if !triggered {
+			execLogf("\tskipping pipeline since it is not triggered by the %v event", eventName)
+			continue
+		}
+
 		var err error
 		activated := pipeline.Trigger == ""
 		if !activated {
 			activated, err = interpreter.EvalExpr("patch", pipeline.Trigger)
 			if err != nil {
-				CollectError(env, err)
-				return nil, err
+				triggerPath := fmt.Sprintf("pipelines[%v].trigger", i)
+				if !file.IgnoreErrors && !pipeline.IgnoreErrors {
+					err = pathError(triggerPath, err)
+					CollectError(env, err)
+					return nil, err
+				}
+
+				ignoreError(env, triggerPath, err)
+				activated = false
 			}
 		}
 
 		if activated {
 			for num, stage := range pipeline.Stages {
 				execLogf("evaluating pipeline stage %v", num)
+				stageActionsPath := fmt.Sprintf("pipelines[%v].stages[%v].actions", i, num)
 				if stage.Until == "" {
-					program.append(stage.Actions)
+					program.append(actions[stageActionsPath])
 					break
 				}
 
 				isDone, err := interpreter.EvalExpr("patch", stage.Until)
 				if err != nil {
-					CollectError(env, err)
-					return nil, err
+					untilPath := fmt.Sprintf("pipelines[%v].stages[%v].until", i, num)
+					if !file.IgnoreErrors && !pipeline.IgnoreErrors {
+						err = pathError(untilPath, err)
+						CollectError(env, err)
+						return nil, err
+					}
+
+					ignoreError(env, untilPath, err)
+					isDone = false
 				}
 
 				if !isDone {
-					program.append(stage.Actions)
+					program.append(actions[stageActionsPath])
 					break
 				}
 			}
@@ -204,3 +331,197 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 
 	return program, nil
 }
+
+// workflowsEvaluationOrder returns the indexes of the workflows in the order they are evaluated:
+// the workflows in the order they are written, followed by the default workflows.
+func workflowsEvaluationOrder(workflows []PadWorkflow) []int {
+	order := make([]int, 0, len(workflows))
+	defaults := make([]int, 0)
+
+	for i, workflow := range workflows {
+		if workflow.Default {
+			defaults = append(defaults, i)
+		} else {
+			order = append(order, i)
+		}
+	}
+
+	return append(order, defaults...)
+}
+
+// explainRule names the rule whose evaluation is given by trace.
+// Inline rules are not named since their trace starts with their spec.
+func explainRule(ruleName, trace string) string {
+	if isInlineRule(ruleName) {
+		return trace
+	}
+
+	return fmt.Sprintf("rule %v:\n%v", ruleName, trace)
+}
+
+// BuildRulePaths maps each rule to the location of its spec in the reviewpad file.
+// Inline rules are written directly in the first workflow that uses them.
+func BuildRulePaths(file *ReviewpadFile) map[string]string {
+	rulePaths := make(map[string]string)
+
+	for i, workflow := range file.Workflows {
+		for j, rule := range workflow.Rules {
+			if _, ok := rulePaths[rule.Rule]; !ok && isInlineRule(rule.Rule) {
+				rulePaths[rule.Rule] = fmt.Sprintf("workflows[%v].if[%v]", i, j)
+			}
+		}
+	}
+
+	for i, rule := range file.Rules {
+		if _, ok := rulePaths[rule.Name]; !ok {
+			rulePaths[rule.Name] = fmt.Sprintf("rules[%v].spec", i)
+		}
+	}
+
+	return rulePaths
+}
+
+// compiledActions holds the compiled statements of each list of actions of the reviewpad file
+// by the location of the list (e.g. workflows[0].then).
+// The statements ignore errors when their file, workflow, pipeline or rule does.
+type compiledActions map[string][]*Statement
+
+// compile parses and type checks the functions, the groups, the rules, the conditions and the actions
+// of the reviewpad file before running anything with side effects.
+// The interpreter keeps the compiled functions, groups and rules, while the compiled actions
+// are returned to be added to the program.
+func compile(file *ReviewpadFile, interpreter Interpreter, rulePaths map[string]string) (compiledActions, error) {
+	for i, function := range file.Functions {
+		err := interpreter.ProcessFunction(function.Name, function.Parameters, function.ReturnType, function.Body)
+		if err != nil {
+			return nil, pathError(fmt.Sprintf("functions[%v]", i), err)
+		}
+	}
+
+	for i, group := range file.Groups {
+		err := interpreter.CompileGroup(group.Name, GroupKind(group.Kind), GroupType(group.Type), group.Spec, group.Param, group.Where)
+		if err != nil {
+			return nil, pathError(groupPath(i, group), err)
+		}
+	}
+
+	usedRules := usedRules(file)
+	for _, rule := range file.Rules {
+		// unused rules are never evaluated, so they cannot fail the run
+		if !usedRules[rule.Name] {
+			execLogf("skipping unused rule %v", rule.Name)
+			continue
+		}
+
+		err := interpreter.CompileExpr(rule.Kind, rule.Spec)
+		if err != nil {
+			return nil, pathError(rulePaths[rule.Name], err)
+		}
+	}
+
+	rulesIgnoringErrors := make(map[string]bool)
+	for _, rule := range file.Rules {
+		rulesIgnoringErrors[rule.Name] = rule.IgnoreErrors
+	}
+
+	actions := make(compiledActions)
+
+	for i, workflow := range file.Workflows {
+		workflowIgnoresErrors := file.IgnoreErrors || workflow.IgnoreErrors
+
+		err := actions.compile(interpreter, workflow.Actions, fmt.Sprintf("workflows[%v].then", i), workflowIgnoresErrors)
+		if err != nil {
+			return nil, err
+		}
+
+		err = actions.compile(interpreter, workflow.ElseActions, fmt.Sprintf("workflows[%v].else", i), workflowIgnoresErrors)
+		if err != nil {
+			return nil, err
+		}
+
+		for j, rule := range workflow.Rules {
+			ruleIgnoresErrors := workflowIgnoresErrors || rulesIgnoringErrors[rule.Rule]
+
+			err := actions.compile(interpreter, rule.Actions, fmt.Sprintf("workflows[%v].if[%v].then", i, j), ruleIgnoresErrors)
+			if err != nil {
+				return nil, err
+			}
+
+			err = actions.compile(interpreter, rule.ExtraActions, fmt.Sprintf("workflows[%v].if[%v].extra-actions", i, j), ruleIgnoresErrors)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, pipeline := range file.Pipelines {
+		if pipeline.Trigger != "" {
+			err := interpreter.CompileExpr("patch", pipeline.Trigger)
+			if err != nil {
+				return nil, pathError(fmt.Sprintf("pipelines[%v].trigger", i), err)
+			}
+		}
+
+		for num, stage := range pipeline.Stages {
+			if stage.Until != "" {
+				err := interpreter.CompileExpr("patch", stage.Until)
+				if err != nil {
+					return nil, pathError(fmt.Sprintf("pipelines[%v].stages[%v].until", i, num), err)
+				}
+			}
+
+			err := actions.compile(interpreter, stage.Actions, fmt.Sprintf("pipelines[%v].stages[%v].actions", i, num), file.IgnoreErrors || pipeline.IgnoreErrors)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// compile compiles a list of actions.
+// The path is the location of the list of actions in the reviewpad file.
+func (actions compiledActions) compile(interpreter Interpreter, statements []string, path string, ignoreErrors bool) error {
+	compiledStatements := make([]*Statement, len(statements))
+	for i, statement := range statements {
+		statementPath := fmt.Sprintf("%v[%v]", path, i)
+
+		compiled, err := interpreter.CompileStatement(statement)
+		if err != nil {
+			return pathError(statementPath, err)
+		}
+
+		compiledStatements[i] = BuildCompiledStatement(statement, statementPath, compiled).WithIgnoreErrors(ignoreErrors)
+	}
+
+	actions[path] = compiledStatements
+
+	return nil
+}
+
+// groupPath returns the location of the expression of a group in the reviewpad file.
+func groupPath(i int, group PadGroup) string {
+	if GroupType(group.Type) == GroupTypeFilter {
+		return fmt.Sprintf("groups[%v].where", i)
+	}
+
+	return fmt.Sprintf("groups[%v].spec", i)
+}
+
+// usedRules returns the names of the rules that are referenced by a workflow or by a call to $rule.
+func usedRules(file *ReviewpadFile) map[string]bool {
+	used := make(map[string]bool)
+
+	for _, workflow := range file.Workflows {
+		for _, rule := range workflow.Rules {
+			used[rule.Rule] = true
+		}
+	}
+
+	for _, ruleName := range getCallsToRuleBuiltIn(file.Groups, file.Rules, file.Workflows) {
+		used[ruleName] = true
+	}
+
+	return used
+}
@@ -119,7 +119,7 @@ func TestEval(t *testing.T) {
 			clientOptions:          []mock.MockBackendOption{mockGetReposLabelsByOwnerByRepoByName("bug")},
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("test-unnamed-label")`),
+					engine.BuildStatementWithPath(`$addLabel("test-unnamed-label")`, "workflows[0].then[0]"),
 				},
 			),
 		},
@@ -128,7 +128,7 @@ func TestEval(t *testing.T) {
 			clientOptions:          []mock.MockBackendOption{mockGetReposLabelsByOwnerByRepoByName("bug")},
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("test-valid-label")`),
+					engine.BuildStatementWithPath(`$addLabel("test-valid-label")`, "workflows[0].then[0]"),
 				},
 			),
 		},
@@ -140,7 +140,7 @@ func TestEval(t *testing.T) {
 			},
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("test-valid-label")`),
+					engine.BuildStatementWithPath(`$addLabel("test-valid-label")`, "workflows[0].then[0]"),
 				},
 			),
 		},
@@ -149,18 +149,59 @@ func TestEval(t *testing.T) {
 			clientOptions:          []mock.MockBackendOption{mockGetReposLabelsByOwnerByRepoByName("test-valid-group")},
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("test-valid-group")`),
+					engine.BuildStatementWithPath(`$addLabel("test-valid-group")`, "workflows[0].then[0]"),
+				},
+			),
+		},
+		"when unused rule is invalid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_unused_invalid_rule.yml",
+			clientOptions:          []mock.MockBackendOption{mockGetReposLabelsByOwnerByRepoByName("test-unused-rule")},
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("test-unused-rule")`, "workflows[0].then[0]"),
 				},
 			),
 		},
 		"when group is invalid": {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_group.yml",
 			clientOptions:          []mock.MockBackendOption{mockGetReposLabelsByOwnerByRepoByName("test-invalid-group")},
-			wantErr:                "ProcessGroup:evalGroup expression is not a valid group",
+			wantErr:                "groups[0].spec: CompileGroup: type error at line 1, column 1: expression is not a valid group\n    2\n    ^",
+		},
+		"when constant is valid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_valid_constant.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("test-valid-constant")`, "workflows[0].then[0]"),
+				},
+			),
+		},
+		"when constant is invalid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_constant.yml",
+			wantErr:                "constants[0]: ProcessConstant: type error at line 1, column 1: value of constant thresholds does not have type map[String]Int\n    {\"small\": \"10\"}\n    ^",
+		},
+		"when function is valid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_valid_function.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("test-valid-function")`, "workflows[0].then[0]"),
+				},
+			),
+		},
+		"when function is invalid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_function.yml",
+			wantErr:                "functions[0]: ProcessFunction: type error at line 1, column 14: body of function isBelow does not have type Int\n    $zeroConst() < $limit\n                 ^",
 		},
 		"when workflow is invalid": {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_workflow.yml",
-			wantErr:                "expression 1 is not a condition",
+			wantErr:                "rules[0].spec: type error at line 1, column 1: expression is not a condition\n    1\n    ^",
+		},
+		"when inline rule is invalid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_inline_rule.yml",
+			wantErr:                "workflows[0].if[0]: parse error at line 1, column 10: unexpected end of input\n    $size() >\n             ^",
+		},
+		"when action is invalid": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_invalid_action.yml",
+			wantErr:                "workflows[0].then[0]: type error at line 1, column 1: type inference failed: mismatch in arg types on addLabel\n    $addLabel(1)\n    ^",
 		},
 		"when no workflow is activated": {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_no_activated_workflows.yml",
@@ -172,7 +213,7 @@ func TestEval(t *testing.T) {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_one_activated_workflow.yml",
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("activate-one-workflow")`),
+					engine.BuildStatementWithPath(`$addLabel("activate-one-workflow")`, "workflows[1].then[0]"),
 				},
 			),
 		},
@@ -180,8 +221,8 @@ func TestEval(t *testing.T) {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_multiple_activated_workflows.yml",
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("activated-workflow-a")`),
-					engine.BuildStatement(`$addLabel("activated-workflow-b")`),
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow-a")`, "workflows[0].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow-b")`, "workflows[1].then[0]"),
 				},
 			),
 		},
@@ -189,8 +230,114 @@ func TestEval(t *testing.T) {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_activated_workflow_with_extra_actions.yml",
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("activated-workflow")`),
-					engine.BuildStatement(`$addLabel("workflow-with-extra-actions")`),
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("workflow-with-extra-actions")`, "workflows[0].if[0].extra-actions[0]"),
+				},
+			),
+		},
+		"when rule fails": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_rule.yml",
+			wantErr:                "rules[0].spec: service unavailable",
+		},
+		"when rule fails and the file ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_rule_ignored_by_file.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[1].then[0]").WithIgnoreErrors(true),
+				},
+			),
+		},
+		"when rule fails and the workflow ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_rule_ignored_by_workflow.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[1].then[0]"),
+				},
+			),
+		},
+		"when rule fails and the rule ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_rule_ignored_by_rule.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[1].then[0]"),
+				},
+			),
+		},
+		"when group fails": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_group.yml",
+			wantErr:                "groups[0].spec: ProcessGroup:evalGroup members unavailable",
+		},
+		"when group fails and the file ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_group_ignored_by_file.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]").WithIgnoreErrors(true),
+				},
+			),
+		},
+		"when pipeline trigger fails": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_pipeline_trigger.yml",
+			wantErr:                "pipelines[0].trigger: service unavailable",
+		},
+		"when pipeline trigger fails and the pipeline ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_failing_pipeline_trigger_ignored_by_pipeline.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-pipeline")`, "pipelines[1].stages[0].actions[0]"),
+				},
+			),
+		},
+		"when activated rule ignores errors": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_rule_actions_ignoring_errors.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("rule-ignoring-errors")`, "workflows[0].if[0].extra-actions[0]").WithIgnoreErrors(true),
+				},
+			),
+		},
+		"when no rule is activated and workflow has else actions": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_else_actions.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("non-activated-workflow")`, "workflows[0].else[0]"),
+				},
+			),
+		},
+		"when activated rule has actions": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_rule_actions.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("tautology")`, "workflows[0].if[1].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("tautology-extra-action")`, "workflows[0].if[1].extra-actions[0]"),
+				},
+			),
+		},
+		"when activated rule is listed twice": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_duplicated_rule.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("first-extra-action")`, "workflows[0].if[0].extra-actions[0]"),
+					engine.BuildStatementWithPath(`$addLabel("second-extra-action")`, "workflows[0].if[1].extra-actions[0]"),
+				},
+			),
+		},
+		"when default workflow is activated": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_activated_default_workflow.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("always-run-workflow")`, "workflows[2].then[0]"),
+					engine.BuildStatementWithPath(`$addLabel("default-workflow")`, "workflows[0].then[0]"),
+				},
+			),
+		},
+		"when default workflow is skipped": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_skipped_default_workflow.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[1].then[0]"),
 				},
 			),
 		},
@@ -198,7 +345,7 @@ func TestEval(t *testing.T) {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_skipped_workflow.yml",
 			wantProgram: engine.BuildProgram(
 				[]*engine.Statement{
-					engine.BuildStatement(`$addLabel("activated-workflow")`),
+					engine.BuildStatementWithPath(`$addLabel("activated-workflow")`, "workflows[0].then[0]"),
 				},
 			),
 		},
@@ -233,12 +380,201 @@ func TestEval(t *testing.T) {
 			if gotErr != nil && gotErr.Error() != test.wantErr {
 				assert.FailNow(t, "Load() error = %v, wantErr %v", gotErr, test.wantErr)
 			}
-			assert.Equal(t, test.wantProgram, gotProgram)
+			assertProgram(t, test.wantProgram, gotProgram)
 		})
 	}
 }
 
+func TestEval_WhenRuleFailsAndErrorsAreIgnored_ReportsWarning(t *testing.T) {
+	mockedClient := engine.MockGithubClient(nil)
+
+	mockedAladinoInterpreter, err := mockAladinoInterpreter(mockedClient)
+	if err != nil {
+		assert.FailNow(t, "mockDefaultAladinoInterpreterWith: %v", err)
+	}
+
+	mockedEnv, err := engine.MockEnvWith(mockedClient, mockedAladinoInterpreter)
+	if err != nil {
+		assert.FailNow(t, "engine MockDefaultEnvWith: %v", err)
+	}
+
+	reviewpadFileData, err := utils.LoadFile("testdata/exec/reviewpad_with_failing_rule_ignored_by_rule.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := testutils.ParseReviewpadFile(reviewpadFileData)
+	if err != nil {
+		assert.FailNow(t, "Error parsing reviewpad file: %v", err)
+	}
+
+	_, err = engine.Eval(reviewpadFile, mockedEnv)
+
+	wantWarnings := []string{"rules[0].spec failed and was treated as false: service unavailable"}
+	gotWarnings := mockedAladinoInterpreter.(*aladino.Interpreter).Env.GetBuiltInsReportedMessages()[aladino.SEVERITY_WARNING]
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantWarnings, gotWarnings)
+}
+
+func TestEval_WhenFileAsksForExplanations_ReportsTheEvaluationOfEveryWorkflow(t *testing.T) {
+	mockedClient := engine.MockGithubClient(nil)
+
+	mockedAladinoInterpreter, err := mockAladinoInterpreter(mockedClient)
+	if err != nil {
+		assert.FailNow(t, "mockDefaultAladinoInterpreterWith: %v", err)
+	}
+
+	mockedEnv, err := engine.MockEnvWith(mockedClient, mockedAladinoInterpreter)
+	if err != nil {
+		assert.FailNow(t, "engine MockDefaultEnvWith: %v", err)
+	}
+
+	reviewpadFileData, err := utils.LoadFile("testdata/exec/reviewpad_with_explain.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := testutils.ParseReviewpadFile(reviewpadFileData)
+	if err != nil {
+		assert.FailNow(t, "Error parsing reviewpad file: %v", err)
+	}
+
+	_, err = engine.Eval(reviewpadFile, mockedEnv)
+
+	gotExplanations := mockedAladinoInterpreter.(*aladino.Interpreter).Env.GetReport().Explanations
+
+	assert.Nil(t, err)
+	assert.Len(t, gotExplanations, 2)
+
+	assert.Equal(t, "activated-workflow", gotExplanations[0].Workflow)
+	assert.Contains(t, gotExplanations[0].Trace, "rule no-changes:\n$zeroConst() == 0 = true")
+	assert.Contains(t, gotExplanations[0].Trace, "\n$zeroConst() > 0 = false")
+	assert.Contains(t, gotExplanations[0].Trace, "calls zeroConst()")
+
+	assert.Equal(t, "skipped-workflow", gotExplanations[1].Workflow)
+	assert.Equal(t, "skipped because a workflow without always-run was activated\n", gotExplanations[1].Trace)
+}
+
+func TestEval_WhenWorkflowsAndPipelinesHaveEvents_SkipsTheOnesNotTriggeredByTheEvent(t *testing.T) {
+	mockedClient := engine.MockGithubClient(nil)
+
+	mockedAladinoInterpreter, err := mockAladinoInterpreter(mockedClient)
+	if err != nil {
+		assert.FailNow(t, "mockDefaultAladinoInterpreterWith: %v", err)
+	}
+
+	mockedEnv, err := engine.MockEnvWith(mockedClient, mockedAladinoInterpreter)
+	if err != nil {
+		assert.FailNow(t, "engine MockDefaultEnvWith: %v", err)
+	}
+
+	mockedEnv.EventPayload = &github.PullRequestEvent{Action: github.String("opened")}
+
+	reviewpadFileData, err := utils.LoadFile("testdata/exec/reviewpad_with_event_triggers.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := testutils.ParseReviewpadFile(reviewpadFileData)
+	if err != nil {
+		assert.FailNow(t, "Error parsing reviewpad file: %v", err)
+	}
+
+	wantProgram := engine.BuildProgram(
+		[]*engine.Statement{
+			engine.BuildStatementWithPath(`$addLabel("opened-workflow")`, "workflows[1].then[0]"),
+			engine.BuildStatementWithPath(`$addLabel("any-event-workflow")`, "workflows[3].then[0]"),
+			engine.BuildStatementWithPath(`$addLabel("opened-pipeline")`, "pipelines[1].stages[0].actions[0]"),
+		},
+	)
+
+	gotProgram, err := engine.Eval(reviewpadFile, mockedEnv)
+
+	assert.Nil(t, err)
+	assertProgram(t, wantProgram, gotProgram)
+}
+
+func TestEval_WhenThereIsNoEvent_TriggersOnlyTheWorkflowsWithoutEvents(t *testing.T) {
+	mockedClient := engine.MockGithubClient(nil)
+
+	mockedAladinoInterpreter, err := mockAladinoInterpreter(mockedClient)
+	if err != nil {
+		assert.FailNow(t, "mockDefaultAladinoInterpreterWith: %v", err)
+	}
+
+	mockedEnv, err := engine.MockEnvWith(mockedClient, mockedAladinoInterpreter)
+	if err != nil {
+		assert.FailNow(t, "engine MockDefaultEnvWith: %v", err)
+	}
+
+	mockedEnv.EventPayload = nil
+
+	reviewpadFileData, err := utils.LoadFile("testdata/exec/reviewpad_with_event_triggers.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := testutils.ParseReviewpadFile(reviewpadFileData)
+	if err != nil {
+		assert.FailNow(t, "Error parsing reviewpad file: %v", err)
+	}
+
+	wantProgram := engine.BuildProgram(
+		[]*engine.Statement{
+			engine.BuildStatementWithPath(`$addLabel("any-event-workflow")`, "workflows[3].then[0]"),
+		},
+	)
+
+	gotProgram, err := engine.Eval(reviewpadFile, mockedEnv)
+
+	assert.Nil(t, err)
+	assertProgram(t, wantProgram, gotProgram)
+}
+
+// assertProgram checks that the program has the code and the location of the wanted statements
+// and that each statement was compiled when the reviewpad file was evaluated.
+func assertProgram(t *testing.T, wantProgram, gotProgram *engine.Program) {
+	if wantProgram == nil {
+		assert.Nil(t, gotProgram)
+		return
+	}
+
+	wantStatements := wantProgram.GetProgramStatements()
+	gotStatements := gotProgram.GetProgramStatements()
+	if !assert.Equal(t, len(wantStatements), len(gotStatements)) {
+		return
+	}
+
+	for i, wantStatement := range wantStatements {
+		assert.Equal(t, wantStatement.GetStatementCode(), gotStatements[i].GetStatementCode())
+		assert.Equal(t, wantStatement.GetStatementPath(), gotStatements[i].GetStatementPath())
+		assert.Equal(t, wantStatement.IgnoresErrors(), gotStatements[i].IgnoresErrors())
+		assert.NotNil(t, gotStatements[i].GetCompiledStatement())
+	}
+}
+
 func mockAladinoInterpreter(githubClient *gh.GithubClient) (engine.Interpreter, error) {
+	builtIns := aladino.MockBuiltIns()
+	builtIns.Functions["unavailable"] = &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
+		Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+			return nil, fmt.Errorf("service unavailable")
+		},
+	}
+	builtIns.Functions["unavailableMembers"] = &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
+		Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+			return nil, fmt.Errorf("members unavailable")
+		},
+	}
+	builtIns.Actions["addLabel"] = &aladino.BuiltInAction{
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code: func(e aladino.Env, args []aladino.Value) error {
+			return nil
+		},
+	}
+
 	dryRun := false
 	mockedAladinoInterpreter, err := aladino.NewInterpreter(
 		engine.DefaultMockCtx,
@@ -247,7 +583,7 @@ func mockAladinoInterpreter(githubClient *gh.GithubClient) (engine.Interpreter,
 		engine.DefaultMockCollector,
 		engine.GetDefaultMockPullRequestDetails(),
 		engine.DefaultMockEventPayload,
-		aladino.MockBuiltIns(),
+		builtIns,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("aladino NewInterpreter returned unexpected error: %v", err)
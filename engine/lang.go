@@ -11,19 +11,28 @@ const (
 	VERBOSE_MODE         string = "verbose"
 )
 
+// PadImport is a reviewpad file to import, given by exactly one of url, path or git.
 type PadImport struct {
 	Url string `yaml:"url"`
+	// Path is relative to the location of the importing file.
+	Path string `yaml:"path"`
+	// Git is a file of a GitHub repository, written as owner/repo/path@ref.
+	// Without a ref, the file is read from the default branch.
+	Git string `yaml:"git"`
+	// Sha256 is the hexadecimal SHA-256 hash that the content of the imported file must have.
+	Sha256 string `yaml:"sha256"`
 }
 
 func (p PadImport) equals(o PadImport) bool {
-	return p.Url == o.Url
+	return p.Url == o.Url && p.Path == o.Path && p.Git == o.Git && p.Sha256 == o.Sha256
 }
 
 type PadRule struct {
-	Name        string `yaml:"name"`
-	Kind        string `yaml:"kind"`
-	Description string `yaml:"description"`
-	Spec        string `yaml:"spec"`
+	Name         string `yaml:"name"`
+	Kind         string `yaml:"kind"`
+	Description  string `yaml:"description"`
+	Spec         string `yaml:"spec"`
+	IgnoreErrors bool   `yaml:"ignore-errors"`
 }
 
 func (p PadRule) equals(o PadRule) bool {
@@ -43,13 +52,19 @@ func (p PadRule) equals(o PadRule) bool {
 		return false
 	}
 
+	if p.IgnoreErrors != o.IgnoreErrors {
+		return false
+	}
+
 	return true
 }
 
 var kinds = []string{"patch", "author"}
 
 type PadWorkflowRule struct {
-	Rule         string   `yaml:"rule"`
+	Rule string `yaml:"rule"`
+	// Actions are run when the rule is activated, after the actions of the workflow.
+	Actions      []string `yaml:"then" mapstructure:"then"`
 	ExtraActions []string `yaml:"extra-actions" mapstructure:"extra-actions"`
 }
 
@@ -58,6 +73,16 @@ func (p PadWorkflowRule) equals(o PadWorkflowRule) bool {
 		return false
 	}
 
+	if len(p.Actions) != len(o.Actions) {
+		return false
+	}
+	for i, pA := range p.Actions {
+		oA := o.Actions[i]
+		if pA != oA {
+			return false
+		}
+	}
+
 	if len(p.ExtraActions) != len(o.ExtraActions) {
 		return false
 	}
@@ -94,12 +119,20 @@ func (p PadLabel) equals(o PadLabel) bool {
 }
 
 type PadWorkflow struct {
-	Name               string            `yaml:"name"`
-	Description        string            `yaml:"description"`
-	AlwaysRun          bool              `yaml:"always-run"`
-	Rules              []PadWorkflowRule `yaml:"-"`
-	Actions            []string          `yaml:"then"`
-	NonNormalizedRules []interface{}     `yaml:"if"`
+	Name         string `yaml:"name"`
+	Description  string `yaml:"description"`
+	AlwaysRun    bool   `yaml:"always-run"`
+	IgnoreErrors bool   `yaml:"ignore-errors"`
+	// Default workflows are evaluated after the other workflows,
+	// and only when no workflow without always-run was activated.
+	Default bool `yaml:"default"`
+	// On are the events that trigger the workflow. A workflow without events is triggered by any event.
+	On      PadEvents         `yaml:"on"`
+	Rules   []PadWorkflowRule `yaml:"-"`
+	Actions []string          `yaml:"then"`
+	// ElseActions are run when the workflow is evaluated and none of its rules is activated.
+	ElseActions        []string      `yaml:"else"`
+	NonNormalizedRules []interface{} `yaml:"if"`
 }
 
 func (p PadWorkflow) equals(o PadWorkflow) bool {
@@ -130,6 +163,18 @@ func (p PadWorkflow) equals(o PadWorkflow) bool {
 		return false
 	}
 
+	if p.IgnoreErrors != o.IgnoreErrors {
+		return false
+	}
+
+	if p.Default != o.Default {
+		return false
+	}
+
+	if !p.On.equals(o.On) {
+		return false
+	}
+
 	for i, pA := range p.Actions {
 		oA := o.Actions[i]
 		if pA != oA {
@@ -137,6 +182,16 @@ func (p PadWorkflow) equals(o PadWorkflow) bool {
 		}
 	}
 
+	if len(p.ElseActions) != len(o.ElseActions) {
+		return false
+	}
+	for i, pE := range p.ElseActions {
+		oE := o.ElseActions[i]
+		if pE != oE {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -178,12 +233,72 @@ func (p PadGroup) equals(o PadGroup) bool {
 	return true
 }
 
+type PadConstant struct {
+	Name  string `yaml:"name"`
+	Type  string `yaml:"type"`
+	Value string `yaml:"value"`
+}
+
+func (p PadConstant) equals(o PadConstant) bool {
+	return p.Name == o.Name && p.Type == o.Type && p.Value == o.Value
+}
+
+type PadFunctionParameter struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+}
+
+func (p PadFunctionParameter) equals(o PadFunctionParameter) bool {
+	return p.Name == o.Name && p.Type == o.Type
+}
+
+type PadFunction struct {
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description"`
+	Parameters  []PadFunctionParameter `yaml:"parameters"`
+	ReturnType  string                 `yaml:"return-type" mapstructure:"return-type"`
+	Body        string                 `yaml:"body"`
+}
+
+func (p PadFunction) equals(o PadFunction) bool {
+	if p.Name != o.Name {
+		return false
+	}
+
+	if p.Description != o.Description {
+		return false
+	}
+
+	if len(p.Parameters) != len(o.Parameters) {
+		return false
+	}
+	for i, pP := range p.Parameters {
+		oP := o.Parameters[i]
+		if !pP.equals(oP) {
+			return false
+		}
+	}
+
+	if p.ReturnType != o.ReturnType {
+		return false
+	}
+
+	if p.Body != o.Body {
+		return false
+	}
+
+	return true
+}
+
 type ReviewpadFile struct {
 	Version      string              `yaml:"api-version"`
 	Edition      string              `yaml:"edition"`
 	Mode         string              `yaml:"mode"`
 	IgnoreErrors bool                `yaml:"ignore-errors"`
+	Explain      bool                `yaml:"explain"`
 	Imports      []PadImport         `yaml:"imports"`
+	Constants    []PadConstant       `yaml:"constants"`
+	Functions    []PadFunction       `yaml:"functions"`
 	Groups       []PadGroup          `yaml:"groups"`
 	Rules        []PadRule           `yaml:"rules"`
 	Labels       map[string]PadLabel `yaml:"labels"`
@@ -192,10 +307,13 @@ type ReviewpadFile struct {
 }
 
 type PadPipeline struct {
-	Name        string     `yaml:"name"`
-	Description string     `yaml:"description"`
-	Trigger     string     `yaml:"trigger"`
-	Stages      []PadStage `yaml:"stages"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// On are the events that trigger the pipeline. A pipeline without events is triggered by any event.
+	On           PadEvents  `yaml:"on"`
+	Trigger      string     `yaml:"trigger"`
+	Stages       []PadStage `yaml:"stages"`
+	IgnoreErrors bool       `yaml:"ignore-errors"`
 }
 
 type PadStage struct {
@@ -220,6 +338,10 @@ func (r *ReviewpadFile) equals(o *ReviewpadFile) bool {
 		return false
 	}
 
+	if r.Explain != o.Explain {
+		return false
+	}
+
 	if len(r.Imports) != len(o.Imports) {
 		return false
 	}
@@ -230,6 +352,26 @@ func (r *ReviewpadFile) equals(o *ReviewpadFile) bool {
 		}
 	}
 
+	if len(r.Constants) != len(o.Constants) {
+		return false
+	}
+	for i, rC := range r.Constants {
+		oC := o.Constants[i]
+		if !rC.equals(oC) {
+			return false
+		}
+	}
+
+	if len(r.Functions) != len(o.Functions) {
+		return false
+	}
+	for i, rF := range r.Functions {
+		oF := o.Functions[i]
+		if !rF.equals(oF) {
+			return false
+		}
+	}
+
 	if len(r.Rules) != len(o.Rules) {
 		return false
 	}
@@ -292,6 +434,34 @@ func (r *ReviewpadFile) appendRules(o *ReviewpadFile) {
 	r.Rules = append(r.Rules, o.Rules...)
 }
 
+// appendConstants adds the constants of an imported file.
+// Imported constants are declared before the constants of the importing file
+// because a constant can only use the constants declared before it.
+func (r *ReviewpadFile) appendConstants(o *ReviewpadFile) {
+	if len(o.Constants) == 0 {
+		return
+	}
+
+	constants := make([]PadConstant, 0, len(o.Constants)+len(r.Constants))
+	constants = append(constants, o.Constants...)
+
+	r.Constants = append(constants, r.Constants...)
+}
+
+// appendFunctions adds the functions of an imported file.
+// Imported functions are declared before the functions of the importing file
+// because a function can only call the functions declared before it.
+func (r *ReviewpadFile) appendFunctions(o *ReviewpadFile) {
+	if len(o.Functions) == 0 {
+		return
+	}
+
+	functions := make([]PadFunction, 0, len(o.Functions)+len(r.Functions))
+	functions = append(functions, o.Functions...)
+
+	r.Functions = append(functions, r.Functions...)
+}
+
 func (r *ReviewpadFile) appendGroups(o *ReviewpadFile) {
 	if r.Groups == nil {
 		r.Groups = make([]PadGroup, 0)
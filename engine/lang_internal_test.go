@@ -19,6 +19,24 @@ var mockedReviewpadFile = &ReviewpadFile{
 	Imports: []PadImport{
 		{Url: "https://foo.bar/draft-rule.yml"},
 	},
+	Constants: []PadConstant{
+		{
+			Name:  "thresholds",
+			Type:  "map[String]Int",
+			Value: `{"small": 10}`,
+		},
+	},
+	Functions: []PadFunction{
+		{
+			Name:        "isSmall",
+			Description: "Checks if the pull request is small",
+			Parameters: []PadFunctionParameter{
+				{Name: "limit", Type: "Int"},
+			},
+			ReturnType: "Bool",
+			Body:       "$size() < $limit",
+		},
+	},
 	Groups: []PadGroup{
 		{
 			Name:        "seniors",
@@ -61,15 +79,15 @@ var mockedReviewpadFile = &ReviewpadFile{
 }
 
 func TestEquals_WhenPadImportsAreEqual(t *testing.T) {
-	padImport := PadImport{"http://foo.bar"}
-	otherPadImport := PadImport{"http://foo.bar"}
+	padImport := PadImport{Url: "http://foo.bar"}
+	otherPadImport := PadImport{Url: "http://foo.bar"}
 
 	assert.True(t, padImport.equals(otherPadImport))
 }
 
 func TestEquals_WhenPadImportsAreDiff(t *testing.T) {
-	padImport := PadImport{"http://foo.bar1"}
-	otherPadImport := PadImport{"http://foo.bar2"}
+	padImport := PadImport{Url: "http://foo.bar1"}
+	otherPadImport := PadImport{Url: "http://foo.bar2"}
 
 	assert.False(t, padImport.equals(otherPadImport))
 }
@@ -164,6 +182,26 @@ func TestEquals_WhenPadRulesHaveDiffSpec(t *testing.T) {
 	assert.False(t, padRule.equals(otherPadRule))
 }
 
+func TestEquals_WhenPadRulesHaveDiffIgnoreErrors(t *testing.T) {
+	padRule := PadRule{
+		Name:         "test-rule",
+		Kind:         "patch",
+		Description:  "testing rule",
+		Spec:         "1 == 1",
+		IgnoreErrors: true,
+	}
+
+	otherPadRule := PadRule{
+		Name:         "test-rule",
+		Kind:         "patch",
+		Description:  "testing rule",
+		Spec:         "1 == 1",
+		IgnoreErrors: false,
+	}
+
+	assert.False(t, padRule.equals(otherPadRule))
+}
+
 func TestEquals_WhenPadWorkflowRulesAreEqual(t *testing.T) {
 	padWorkflowRule := PadWorkflowRule{
 		Rule: "test-rule",
@@ -200,6 +238,24 @@ func TestEquals_WhenPadWorkflowRulesHaveDiffRules(t *testing.T) {
 	assert.False(t, padWorkflowRule.equals(otherPadWorkflowRule))
 }
 
+func TestEquals_WhenPadWorkflowRulesHaveDiffActions(t *testing.T) {
+	padWorkflowRule := PadWorkflowRule{
+		Rule: "test-rule",
+		Actions: []string{
+			"$action1()",
+		},
+	}
+
+	otherPadWorkflowRule := PadWorkflowRule{
+		Rule: "test-rule",
+		Actions: []string{
+			"$action2()",
+		},
+	}
+
+	assert.False(t, padWorkflowRule.equals(otherPadWorkflowRule))
+}
+
 func TestEquals_WhenPadWorkflowRulesHaveDiffExtraActionsLength(t *testing.T) {
 	padWorkflowRule := PadWorkflowRule{
 		Rule: "test-rule",
@@ -578,6 +634,176 @@ func TestEquals_WhenPadWorkflowsHaveDiffAlwaysRun(t *testing.T) {
 	assert.False(t, padWorkflow.equals(otherPadWorkflow))
 }
 
+func TestEquals_WhenPadWorkflowsHaveDiffIgnoreErrors(t *testing.T) {
+	padWorkflow := PadWorkflow{
+		Name:         "test",
+		Description:  "Test process",
+		IgnoreErrors: true,
+		Rules: []PadWorkflowRule{
+			{
+				Rule:         "tautology",
+				ExtraActions: []string{},
+			},
+		},
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	otherPadWorkflow := PadWorkflow{
+		Name:         "test",
+		Description:  "Test process",
+		IgnoreErrors: false,
+		Rules: []PadWorkflowRule{
+			{
+				Rule:         "tautology",
+				ExtraActions: []string{},
+			},
+		},
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	assert.False(t, padWorkflow.equals(otherPadWorkflow))
+}
+
+func TestEquals_WhenPadWorkflowsHaveDiffDefault(t *testing.T) {
+	padWorkflow := PadWorkflow{
+		Name:    "test",
+		Default: true,
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	otherPadWorkflow := PadWorkflow{
+		Name:    "test",
+		Default: false,
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	assert.False(t, padWorkflow.equals(otherPadWorkflow))
+}
+
+func TestEquals_WhenPadWorkflowsHaveDiffEvents(t *testing.T) {
+	padWorkflow := PadWorkflow{
+		Name: "test",
+		On:   PadEvents{"pull_request": {"opened"}},
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	otherPadWorkflow := PadWorkflow{
+		Name: "test",
+		On:   PadEvents{"pull_request": {"synchronize"}},
+		Actions: []string{
+			"$action()",
+		},
+	}
+
+	assert.False(t, padWorkflow.equals(otherPadWorkflow))
+}
+
+func TestEquals_WhenPadWorkflowsHaveDiffElseActions(t *testing.T) {
+	padWorkflow := PadWorkflow{
+		Name: "test",
+		Rules: []PadWorkflowRule{
+			{
+				Rule: "tautology",
+			},
+		},
+		ElseActions: []string{
+			"$action1()",
+		},
+	}
+
+	otherPadWorkflow := PadWorkflow{
+		Name: "test",
+		Rules: []PadWorkflowRule{
+			{
+				Rule: "tautology",
+			},
+		},
+		ElseActions: []string{
+			"$action2()",
+		},
+	}
+
+	assert.False(t, padWorkflow.equals(otherPadWorkflow))
+}
+
+func TestEquals_WhenPadConstantsAreEqual(t *testing.T) {
+	padConstant := PadConstant{
+		Name:  "thresholds",
+		Type:  "map[String]Int",
+		Value: `{"small": 10}`,
+	}
+
+	otherPadConstant := PadConstant{
+		Name:  "thresholds",
+		Type:  "map[String]Int",
+		Value: `{"small": 10}`,
+	}
+
+	assert.True(t, padConstant.equals(otherPadConstant))
+}
+
+func TestEquals_WhenPadConstantsHaveDiffValues(t *testing.T) {
+	padConstant := PadConstant{
+		Name:  "thresholds",
+		Type:  "map[String]Int",
+		Value: `{"small": 10}`,
+	}
+
+	otherPadConstant := PadConstant{
+		Name:  "thresholds",
+		Type:  "map[String]Int",
+		Value: `{"small": 20}`,
+	}
+
+	assert.False(t, padConstant.equals(otherPadConstant))
+}
+
+func TestEquals_WhenPadFunctionsAreEqual(t *testing.T) {
+	padFunction := PadFunction{
+		Name:       "isSmall",
+		Parameters: []PadFunctionParameter{{Name: "limit", Type: "Int"}},
+		ReturnType: "Bool",
+		Body:       "$size() < $limit",
+	}
+
+	otherPadFunction := PadFunction{
+		Name:       "isSmall",
+		Parameters: []PadFunctionParameter{{Name: "limit", Type: "Int"}},
+		ReturnType: "Bool",
+		Body:       "$size() < $limit",
+	}
+
+	assert.True(t, padFunction.equals(otherPadFunction))
+}
+
+func TestEquals_WhenPadFunctionsHaveDiffParameters(t *testing.T) {
+	padFunction := PadFunction{
+		Name:       "isSmall",
+		Parameters: []PadFunctionParameter{{Name: "limit", Type: "Int"}},
+		ReturnType: "Bool",
+		Body:       "$size() < $limit",
+	}
+
+	otherPadFunction := PadFunction{
+		Name:       "isSmall",
+		Parameters: []PadFunctionParameter{{Name: "limit", Type: "String"}},
+		ReturnType: "Bool",
+		Body:       "$size() < $limit",
+	}
+
+	assert.False(t, padFunction.equals(otherPadFunction))
+}
+
 func TestEquals_WhenPadGroupsAreEqual(t *testing.T) {
 	padGroup := PadGroup{
 		Name:        "juniors",
@@ -760,6 +986,15 @@ func TestEquals_WhenReviewpadFilesHaveDiffIgnoreErrors(t *testing.T) {
 	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
 }
 
+func TestEquals_WhenReviewpadFilesHaveDiffExplain(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Explain = true
+
+	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
+}
+
 func TestEquals_WhenReviewpadFilesHaveDiffNumberOfImports(t *testing.T) {
 	otherReviewpadFile := &ReviewpadFile{}
 	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
@@ -783,6 +1018,22 @@ func TestEquals_WhenReviewpadFilesHaveDiffImports(t *testing.T) {
 	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
 }
 
+func TestEquals_WhenReviewpadFilesHaveDiffFunctions(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Functions = []PadFunction{
+		{
+			Name:       "isSmall",
+			Parameters: []PadFunctionParameter{},
+			ReturnType: "Bool",
+			Body:       "$size() < 10",
+		},
+	}
+
+	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
+}
+
 func TestEquals_WhenReviewpadFilesHaveDiffNumberOfRules(t *testing.T) {
 	otherReviewpadFile := &ReviewpadFile{}
 	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
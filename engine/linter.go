@@ -45,7 +45,11 @@ func getAllMatches(pattern string, groups []PadGroup, rules []PadRule, workflows
 	}
 
 	for _, workflow := range workflows {
-		actions := workflow.Actions
+		actions := append(append([]string{}, workflow.Actions...), workflow.ElseActions...)
+		for _, rule := range workflow.Rules {
+			actions = append(append(actions, rule.Actions...), rule.ExtraActions...)
+		}
+
 		groupFunctionCalls := make([]string, 0)
 		for _, action := range actions {
 			groupFunctionCalls = append(groupFunctionCalls, rePatternFnCall.FindAllString(action, -1)...)
@@ -94,6 +98,88 @@ func lintRules(padRules []PadRule) error {
 	return nil
 }
 
+// Validations:
+// - Every constant has a (unique) name
+// - Every constant has a type and a value
+func lintConstants(padConstants []PadConstant) error {
+	constantsName := make([]string, 0)
+
+	for _, constant := range padConstants {
+		lintLog("analyzing constant %v", constant.Name)
+
+		if constant.Name == "" {
+			return lintError("constant %v has invalid name", constant)
+		}
+
+		for _, constantName := range constantsName {
+			if constantName == constant.Name {
+				return lintError("constant with the name %v already exists", constant.Name)
+			}
+		}
+
+		if constant.Type == "" {
+			return lintError("constant %v has no type", constant.Name)
+		}
+
+		if constant.Value == "" {
+			return lintError("constant %v has empty value", constant.Name)
+		}
+
+		constantsName = append(constantsName, constant.Name)
+	}
+
+	return nil
+}
+
+// Validations:
+// - Every function has a (unique) name
+// - Every function parameter has a (unique) name and a type
+// - Every function has a body
+func lintFunctions(padFunctions []PadFunction) error {
+	functionsName := make([]string, 0)
+
+	for _, function := range padFunctions {
+		lintLog("analyzing function %v", function.Name)
+
+		if function.Name == "" {
+			return lintError("function %v has invalid name", function)
+		}
+
+		for _, functionName := range functionsName {
+			if functionName == function.Name {
+				return lintError("function with the name %v already exists", function.Name)
+			}
+		}
+
+		parametersName := make([]string, 0)
+		for _, parameter := range function.Parameters {
+			if parameter.Name == "" {
+				return lintError("function %v has a parameter with invalid name", function.Name)
+			}
+
+			if parameter.Type == "" {
+				return lintError("parameter %v of function %v has no type", parameter.Name, function.Name)
+			}
+
+			for _, parameterName := range parametersName {
+				if parameterName == parameter.Name {
+					return lintError("function %v has more than one parameter with the name %v", function.Name, parameter.Name)
+				}
+			}
+
+			parametersName = append(parametersName, parameter.Name)
+		}
+
+		if function.Body == "" {
+			return lintError("function %v has empty body", function.Name)
+		}
+
+		functionsName = append(functionsName, function.Name)
+	}
+
+	return nil
+}
+
 // Validations:
 // - Group has unique name
 func lintGroups(padGroups []PadGroup) error {
@@ -120,17 +206,19 @@ func lintGroups(padGroups []PadGroup) error {
 
 // Validations:
 // - Workflow has unique name
-// - Workflow has rules
+// - Workflow has rules, unless it is a default workflow
 // - Workflow has non empty rules
+// - Workflow is triggered by supported events
 // - Workflow has only known rules
+// - Workflow is not both a default workflow and always run
 func lintWorkflows(rules []PadRule, padWorkflows []PadWorkflow) error {
 	workflowsName := make([]string, 0)
-	workflowHasExtraActions := false
 
 	for _, workflow := range padWorkflows {
 		lintLog("analyzing workflow %v", workflow.Name)
 
-		workflowHasActions := len(workflow.Actions) > 0
+		workflowHasActions := len(workflow.Actions) > 0 || len(workflow.ElseActions) > 0
+		workflowHasRuleActions := false
 
 		for _, workflowName := range workflowsName {
 			if workflowName == workflow.Name {
@@ -138,7 +226,16 @@ func lintWorkflows(rules []PadRule, padWorkflows []PadWorkflow) error {
 			}
 		}
 
-		if len(workflow.Rules) == 0 {
+		if workflow.Default && workflow.AlwaysRun {
+			return lintError("workflow %v cannot be a default workflow and always run", workflow.Name)
+		}
+
+		err := lintEvents(workflow.On)
+		if err != nil {
+			return err
+		}
+
+		if len(workflow.Rules) == 0 && !workflow.Default {
 			return lintError("workflow %v does not have rules", workflow.Name)
 		}
 
@@ -153,13 +250,15 @@ func lintWorkflows(rules []PadRule, padWorkflows []PadWorkflow) error {
 				return lintError("rule %v is unknown", ruleName)
 			}
 
-			workflowHasExtraActions = len(rule.ExtraActions) > 0
-			if !workflowHasExtraActions && !workflowHasActions {
+			ruleHasActions := len(rule.Actions) > 0 || len(rule.ExtraActions) > 0
+			if !ruleHasActions && !workflowHasActions {
 				lintLog("warning: rule %v will be ignored since it has no actions", ruleName)
 			}
+
+			workflowHasRuleActions = workflowHasRuleActions || ruleHasActions
 		}
 
-		if !workflowHasActions && !workflowHasExtraActions {
+		if !workflowHasActions && !workflowHasRuleActions {
 			lintLog("warning: workflow has no actions")
 		}
 
@@ -169,6 +268,39 @@ func lintWorkflows(rules []PadRule, padWorkflows []PadWorkflow) error {
 	return nil
 }
 
+// Validations:
+// - Pipeline is triggered by supported events
+func lintPipelines(padPipelines []PadPipeline) error {
+	for _, pipeline := range padPipelines {
+		lintLog("analyzing pipeline %v", pipeline.Name)
+
+		err := lintEvents(pipeline.On)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func lintEvents(events PadEvents) error {
+	for _, name := range events.names() {
+		isSupported := false
+		for _, supportedEvent := range supportedEvents {
+			if name == supportedEvent {
+				isSupported = true
+				break
+			}
+		}
+
+		if !isSupported {
+			return lintError("event %v is not supported", name)
+		}
+	}
+
+	return nil
+}
+
 // Validations
 // - Check that all rules are being used
 // - Check that all referenced rules exist
@@ -246,7 +378,17 @@ func lintGroupsMentions(groups []PadGroup, rules []PadRule, workflows []PadWorkf
 }
 
 func Lint(file *ReviewpadFile) error {
-	err := lintGroups(file.Groups)
+	err := lintConstants(file.Constants)
+	if err != nil {
+		return err
+	}
+
+	err = lintFunctions(file.Functions)
+	if err != nil {
+		return err
+	}
+
+	err = lintGroups(file.Groups)
 	if err != nil {
 		return err
 	}
@@ -261,6 +403,11 @@ func Lint(file *ReviewpadFile) error {
 		return err
 	}
 
+	err = lintPipelines(file.Pipelines)
+	if err != nil {
+		return err
+	}
+
 	err = lintRulesMentions(file.Rules, file.Groups, file.Workflows)
 	if err != nil {
 		return err
@@ -46,3 +46,108 @@ func TestGetCallsToRuleBuiltIn(t *testing.T) {
 
 	assert.Equal(t, wantRuleNames, gotRuleNames)
 }
+
+func TestLintWorkflows_WhenDefaultWorkflowHasNoRules(t *testing.T) {
+	workflows := []PadWorkflow{
+		{
+			Name:    "default-workflow",
+			Default: true,
+			Actions: []string{`$addLabel("default")`},
+		},
+	}
+
+	err := lintWorkflows([]PadRule{}, workflows)
+
+	assert.Nil(t, err)
+}
+
+func TestLintWorkflows_WhenWorkflowHasNoRules(t *testing.T) {
+	workflows := []PadWorkflow{
+		{
+			Name:    "workflow",
+			Actions: []string{`$addLabel("workflow")`},
+		},
+	}
+
+	err := lintWorkflows([]PadRule{}, workflows)
+
+	assert.EqualError(t, err, "[lint] workflow workflow does not have rules")
+}
+
+func TestLintWorkflows_WhenDefaultWorkflowAlwaysRuns(t *testing.T) {
+	workflows := []PadWorkflow{
+		{
+			Name:      "default-workflow",
+			Default:   true,
+			AlwaysRun: true,
+			Actions:   []string{`$addLabel("default")`},
+		},
+	}
+
+	err := lintWorkflows([]PadRule{}, workflows)
+
+	assert.EqualError(t, err, "[lint] workflow default-workflow cannot be a default workflow and always run")
+}
+
+func TestLintWorkflows_WhenEventIsNotSupported(t *testing.T) {
+	workflows := []PadWorkflow{
+		{
+			Name:    "workflow",
+			On:      PadEvents{"pull_request": nil, "star": nil},
+			Rules:   []PadWorkflowRule{{Rule: "tautology"}},
+			Actions: []string{`$addLabel("workflow")`},
+		},
+	}
+
+	err := lintWorkflows([]PadRule{{Name: "tautology", Kind: "patch", Spec: "true"}}, workflows)
+
+	assert.EqualError(t, err, "[lint] event star is not supported")
+}
+
+func TestLintPipelines_WhenEventIsNotSupported(t *testing.T) {
+	pipelines := []PadPipeline{
+		{
+			Name: "pipeline",
+			On:   PadEvents{"fork": nil},
+		},
+	}
+
+	err := lintPipelines(pipelines)
+
+	assert.EqualError(t, err, "[lint] event fork is not supported")
+}
+
+func TestLintRulesMentions_WhenRuleIsUnused(t *testing.T) {
+	rules := []PadRule{
+		{Name: "used-rule", Kind: "patch", Spec: "true"},
+		{Name: "unused-rule", Kind: "patch", Spec: "true"},
+	}
+	workflows := []PadWorkflow{
+		{
+			Name:    "workflow",
+			Rules:   []PadWorkflowRule{{Rule: "used-rule"}},
+			Actions: []string{`$addLabel("used")`},
+		},
+	}
+
+	err := lintRulesMentions(rules, []PadGroup{}, workflows)
+
+	assert.EqualError(t, err, "[lint] unused rule unused-rule")
+}
+
+func TestLintRulesMentions_WhenRuleIsNotDefined(t *testing.T) {
+	rules := []PadRule{
+		{Name: "used-rule", Kind: "patch", Spec: `$rule("missing-rule")`},
+	}
+	workflows := []PadWorkflow{
+		{
+			Name:    "workflow",
+			Rules:   []PadWorkflowRule{{Rule: "used-rule"}},
+			Actions: []string{`$addLabel("used")`},
+		},
+	}
+
+	err := lintRulesMentions(rules, []PadGroup{}, workflows)
+
+	assert.EqualError(t, err, "[lint] the rule missing-rule isn't defined")
+}
@@ -5,27 +5,78 @@
 package engine
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
+	"github.com/google/go-github/v45/github"
 	"github.com/mitchellh/mapstructure"
+	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
 	"gopkg.in/yaml.v3"
 )
 
+const inlineRulePrefix = "inline rule "
+
 type LoadEnv struct {
+	Ctx context.Context
+	// GithubClient downloads the imports by git reference. Without it, such imports fail.
+	GithubClient *gh.GithubClient
+	// Root is the directory of the loaded reviewpad file. The imports by path cannot leave it.
+	Root    string
 	Visited map[string]bool
 	Stack   map[string]bool
 }
 
+// LoadOptions configures how the imports of a reviewpad file are resolved.
+type LoadOptions struct {
+	// Dir is the directory of the reviewpad file, usually where it was read from.
+	// The imports by path are resolved against it and must be inside it.
+	Dir string
+	// GithubClient downloads the imports by git reference. Without it, such imports fail.
+	GithubClient *gh.GithubClient
+}
+
+// importSource is the location of a loaded reviewpad file.
+// The imports by path of a file are resolved against the location of the file,
+// so they are read from the same directory, web server or repository.
+type importSource struct {
+	// dir is the local directory of a file read from disk.
+	dir string
+	// url is the address of a file downloaded from the web.
+	url *url.URL
+	// git is the repository file of a file imported by git reference.
+	git *gitFile
+}
+
+// gitFile is a file of a GitHub repository at a given ref.
+type gitFile struct {
+	owner string
+	repo  string
+	path  string
+	ref   string
+}
+
 func hash(data []byte) string {
 	dataHash := sha256.Sum256(data)
 	dHash := fmt.Sprintf("%x", dataHash)
 	return dHash
 }
 
+// Load parses a reviewpad file and inlines its imports.
+// The imports by path are resolved against the current directory and the imports by git reference fail.
 func Load(data []byte) (*ReviewpadFile, error) {
+	return LoadWithOptions(context.Background(), data, LoadOptions{})
+}
+
+// LoadWithOptions parses a reviewpad file and inlines its imports as configured by options.
+func LoadWithOptions(ctx context.Context, data []byte, options LoadOptions) (*ReviewpadFile, error) {
 	file, err := parse(data)
 	if err != nil {
 		return nil, err
@@ -38,22 +89,22 @@ func Load(data []byte) (*ReviewpadFile, error) {
 	visited[dHash] = true
 	stack[dHash] = true
 
-	env := &LoadEnv{
-		Visited: visited,
-		Stack:   stack,
-	}
+	root := filepath.Clean(options.Dir)
 
-	file, err = processImports(file, env)
-	if err != nil {
-		return nil, err
+	env := &LoadEnv{
+		Ctx:          ctx,
+		GithubClient: options.GithubClient,
+		Root:         root,
+		Visited:      visited,
+		Stack:        stack,
 	}
 
-	file, err = processInlineRules(file)
+	file, err = processImports(file, importSource{dir: root}, env)
 	if err != nil {
 		return nil, err
 	}
 
-	return transform(file), nil
+	return processInlineRules(file)
 }
 
 func parse(data []byte) (*ReviewpadFile, error) {
@@ -66,101 +117,178 @@ func parse(data []byte) (*ReviewpadFile, error) {
 	return &file, nil
 }
 
-func transform(file *ReviewpadFile) *ReviewpadFile {
-	var transformedWorkflows []PadWorkflow
-	for _, workflow := range file.Workflows {
-		var transformedRules []PadWorkflowRule
-		for _, rule := range workflow.Rules {
-			var transformedExtraActions []string
-			for _, extraAction := range rule.ExtraActions {
-				transformedExtraActions = append(transformedExtraActions, transformActionStr(extraAction))
-			}
+func loadImport(reviewpadImport PadImport, source importSource, env *LoadEnv) (*ReviewpadFile, importSource, string, error) {
+	content, importedSource, err := readImport(reviewpadImport, source, env)
+	if err != nil {
+		return nil, importSource{}, "", err
+	}
 
-			transformedRules = append(transformedRules, PadWorkflowRule{
-				Rule:         rule.Rule,
-				ExtraActions: transformedExtraActions,
-			})
-		}
+	contentHash := hash(content)
 
-		var transformedActions []string
-		for _, action := range workflow.Actions {
-			transformedActions = append(transformedActions, transformActionStr(action))
+	if reviewpadImport.Sha256 != "" && !strings.EqualFold(reviewpadImport.Sha256, contentHash) {
+		return nil, importSource{}, "", fmt.Errorf("loader: import %v has sha256 %v but %v was expected", importName(reviewpadImport), contentHash, reviewpadImport.Sha256)
+	}
+
+	file, err := parse(content)
+	if err != nil {
+		return nil, importSource{}, "", err
+	}
+
+	return file, importedSource, contentHash, nil
+}
+
+// readImport returns the content of an import and its location.
+func readImport(reviewpadImport PadImport, source importSource, env *LoadEnv) ([]byte, importSource, error) {
+	kinds := 0
+	for _, location := range []string{reviewpadImport.Url, reviewpadImport.Path, reviewpadImport.Git} {
+		if location != "" {
+			kinds++
 		}
+	}
 
-		transformedWorkflows = append(transformedWorkflows, PadWorkflow{
-			Name:        workflow.Name,
-			Description: workflow.Description,
-			Rules:       transformedRules,
-			Actions:     transformedActions,
-			AlwaysRun:   workflow.AlwaysRun,
-		})
+	if kinds != 1 {
+		return nil, importSource{}, fmt.Errorf("loader: an import must have exactly one of url, path or git")
 	}
 
-	var transformedPipelines []PadPipeline
+	switch {
+	case reviewpadImport.Url != "":
+		return readUrl(reviewpadImport.Url)
+	case reviewpadImport.Git != "":
+		file, err := parseGitFile(reviewpadImport.Git)
+		if err != nil {
+			return nil, importSource{}, err
+		}
 
-	for _, pipeline := range file.Pipelines {
-		var transformedStages []PadStage
+		return readGitFile(file, env)
+	}
 
-		for _, stage := range pipeline.Stages {
-			var transformedActions []string
-			for _, action := range stage.Actions {
-				transformedActions = append(transformedActions, transformActionStr(action))
-			}
+	importPath := reviewpadImport.Path
 
-			transformedStages = append(transformedStages, PadStage{
-				Actions: transformedActions,
-				Until:   stage.Until,
-			})
+	// a path imported by a file from the web or from a repository is read from the same place
+	if source.url != nil {
+		relativeUrl, err := url.Parse(importPath)
+		if err != nil {
+			return nil, importSource{}, err
 		}
 
-		transformedPipelines = append(transformedPipelines, PadPipeline{
-			Name:        pipeline.Name,
-			Description: pipeline.Description,
-			Trigger:     pipeline.Trigger,
-			Stages:      transformedStages,
-		})
+		return readUrl(source.url.ResolveReference(relativeUrl).String())
 	}
 
-	return &ReviewpadFile{
-		Version:      file.Version,
-		Edition:      file.Edition,
-		Mode:         file.Mode,
-		IgnoreErrors: file.IgnoreErrors,
-		Imports:      file.Imports,
-		Groups:       file.Groups,
-		Rules:        file.Rules,
-		Labels:       file.Labels,
-		Workflows:    transformedWorkflows,
-		Pipelines:    transformedPipelines,
+	if source.git != nil {
+		return readGitFile(&gitFile{
+			owner: source.git.owner,
+			repo:  source.git.repo,
+			path:  path.Join(path.Dir(source.git.path), importPath),
+			ref:   source.git.ref,
+		}, env)
+	}
+
+	// a local file can only import the files of the directory of the reviewpad file
+	if filepath.IsAbs(importPath) {
+		return nil, importSource{}, fmt.Errorf("loader: import %v must be a relative path", importPath)
 	}
+
+	importPath = filepath.Join(source.dir, importPath)
+
+	relativePath, err := filepath.Rel(env.Root, importPath)
+	if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return nil, importSource{}, fmt.Errorf("loader: import %v is outside of %v", reviewpadImport.Path, env.Root)
+	}
+
+	content, err := os.ReadFile(importPath)
+	if err != nil {
+		return nil, importSource{}, err
+	}
+
+	return content, importSource{dir: filepath.Dir(importPath)}, nil
 }
 
-func loadImport(reviewpadImport PadImport) (*ReviewpadFile, string, error) {
-	resp, err := http.Get(reviewpadImport.Url)
+func readUrl(rawUrl string) ([]byte, importSource, error) {
+	resp, err := http.Get(rawUrl)
 	if err != nil {
-		return nil, "", err
+		return nil, importSource{}, err
 	}
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, importSource{}, fmt.Errorf("loader: import %v failed with status %v", rawUrl, resp.Status)
+	}
+
 	content, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, importSource{}, err
 	}
 
-	file, err := parse(content)
+	source, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, importSource{}, err
+	}
+
+	return content, importSource{url: source}, nil
+}
+
+func readGitFile(file *gitFile, env *LoadEnv) ([]byte, importSource, error) {
+	if env.GithubClient == nil {
+		return nil, importSource{}, fmt.Errorf("loader: a GitHub client is required to import %v/%v/%v", file.owner, file.repo, file.path)
+	}
+
+	branch := &github.PullRequestBranch{
+		Ref: github.String(file.ref),
+		Repo: &github.Repository{
+			Owner: &github.User{
+				Login: github.String(file.owner),
+			},
+			Name: github.String(file.repo),
+		},
+	}
+
+	content, err := env.GithubClient.DownloadContents(env.Ctx, file.path, branch)
 	if err != nil {
-		return nil, "", err
+		return nil, importSource{}, err
 	}
 
-	return file, hash(content), nil
+	return content, importSource{git: file}, nil
+}
+
+// parseGitFile parses a git reference written as owner/repo/path@ref.
+func parseGitFile(reference string) (*gitFile, error) {
+	location, ref := reference, ""
+	if at := strings.LastIndex(reference, "@"); at >= 0 {
+		location, ref = reference[:at], reference[at+1:]
+	}
+
+	parts := strings.SplitN(location, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("loader: git import %v must be written as owner/repo/path@ref", reference)
+	}
+
+	return &gitFile{
+		owner: parts[0],
+		repo:  parts[1],
+		path:  parts[2],
+		ref:   ref,
+	}, nil
+}
+
+// importName returns how an import is written in the reviewpad file.
+func importName(reviewpadImport PadImport) string {
+	switch {
+	case reviewpadImport.Url != "":
+		return reviewpadImport.Url
+	case reviewpadImport.Git != "":
+		return reviewpadImport.Git
+	}
+
+	return reviewpadImport.Path
 }
 
 // processImports inlines the imports files into the current reviewpad file
+// The imports by path are resolved against the source of the file.
 // Post-condition: ReviewpadFile without import statements
-func processImports(file *ReviewpadFile, env *LoadEnv) (*ReviewpadFile, error) {
+func processImports(file *ReviewpadFile, source importSource, env *LoadEnv) (*ReviewpadFile, error) {
 	for _, reviewpadImport := range file.Imports {
-		iFile, idHash, err := loadImport(reviewpadImport)
+		iFile, iSource, idHash, err := loadImport(reviewpadImport, source, env)
 		if err != nil {
 			return nil, err
 		}
@@ -180,7 +308,7 @@ func processImports(file *ReviewpadFile, env *LoadEnv) (*ReviewpadFile, error) {
 		env.Stack[idHash] = true
 		env.Visited[idHash] = true
 
-		subTreeFile, err := processImports(iFile, env)
+		subTreeFile, err := processImports(iFile, iSource, env)
 		if err != nil {
 			return nil, err
 		}
@@ -188,8 +316,10 @@ func processImports(file *ReviewpadFile, env *LoadEnv) (*ReviewpadFile, error) {
 		// remove from the stack
 		delete(env.Stack, idHash)
 
-		// append labels, rules and workflows
+		// append labels, constants, functions, groups, rules and workflows
 		file.appendLabels(subTreeFile)
+		file.appendConstants(subTreeFile)
+		file.appendFunctions(subTreeFile)
 		file.appendGroups(subTreeFile)
 		file.appendRules(subTreeFile)
 		file.appendWorkflows(subTreeFile)
@@ -209,11 +339,15 @@ func processInlineRules(file *ReviewpadFile) (*ReviewpadFile, error) {
 		Edition:      file.Edition,
 		Mode:         file.Mode,
 		IgnoreErrors: file.IgnoreErrors,
+		Explain:      file.Explain,
 		Imports:      file.Imports,
+		Constants:    file.Constants,
+		Functions:    file.Functions,
 		Groups:       file.Groups,
 		Rules:        file.Rules,
 		Labels:       file.Labels,
 		Workflows:    file.Workflows,
+		Pipelines:    file.Pipelines,
 	}
 
 	for i, workflow := range reviewpadFile.Workflows {
@@ -231,18 +365,22 @@ func processInlineRules(file *ReviewpadFile) (*ReviewpadFile, error) {
 
 func processInlineRulesOnWorkflow(workflow PadWorkflow) (*PadWorkflow, []PadRule, error) {
 	wf := &PadWorkflow{
-		Name:        workflow.Name,
-		Description: workflow.Description,
-		AlwaysRun:   workflow.AlwaysRun,
-		Rules:       workflow.Rules,
-		Actions:     workflow.Actions,
+		Name:         workflow.Name,
+		Description:  workflow.Description,
+		AlwaysRun:    workflow.AlwaysRun,
+		IgnoreErrors: workflow.IgnoreErrors,
+		Default:      workflow.Default,
+		On:           workflow.On,
+		Rules:        workflow.Rules,
+		Actions:      workflow.Actions,
+		ElseActions:  workflow.ElseActions,
 	}
 	foundInlineRules := make([]PadRule, 0)
 
 	for _, rule := range workflow.NonNormalizedRules {
 		switch r := rule.(type) {
 		case string:
-			name := fmt.Sprintf("inline rule %s", r)
+			name := buildInlineRuleName(r)
 
 			foundInlineRules = append(foundInlineRules, PadRule{
 				Name:        name,
@@ -273,6 +411,14 @@ func processInlineRulesOnWorkflow(workflow PadWorkflow) (*PadWorkflow, []PadRule
 	return wf, foundInlineRules, nil
 }
 
+func buildInlineRuleName(spec string) string {
+	return fmt.Sprintf("%v%v", inlineRulePrefix, spec)
+}
+
+func isInlineRule(name string) bool {
+	return strings.HasPrefix(name, inlineRulePrefix)
+}
+
 func mapToPadWorkflowRule(rule map[string]interface{}) (*PadWorkflowRule, error) {
 	r := &PadWorkflowRule{}
 	err := mapstructure.Decode(rule, r)
@@ -59,3 +59,65 @@ pipelines:
 	assert.Nil(t, err)
 	assert.Equal(t, wantFile, gotFile)
 }
+
+func TestProcessInlineRules_KeepsPipelines(t *testing.T) {
+	pipelines := []PadPipeline{
+		{
+			Name:    "assign-reviewers",
+			Trigger: "$size() <= 30",
+			Stages: []PadStage{
+				{Actions: []string{"$assignReviewer([\"marcelosousa\"])"}},
+			},
+		},
+	}
+
+	gotFile, err := processInlineRules(&ReviewpadFile{
+		Workflows: []PadWorkflow{
+			{
+				Name:               "label",
+				NonNormalizedRules: []interface{}{"$size() > 30"},
+				Actions:            []string{"$addLabel(\"large\")"},
+			},
+		},
+		Pipelines: pipelines,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, pipelines, gotFile.Pipelines)
+	assert.Len(t, gotFile.Rules, 1)
+}
+
+func TestParseGitFile(t *testing.T) {
+	tests := map[string]struct {
+		reference   string
+		wantGitFile *gitFile
+		wantErr     string
+	}{
+		"when reference has a ref": {
+			reference:   "reviewpad/policies/shared/reviewpad.yml@v1.2.0",
+			wantGitFile: &gitFile{owner: "reviewpad", repo: "policies", path: "shared/reviewpad.yml", ref: "v1.2.0"},
+		},
+		"when reference has no ref": {
+			reference:   "reviewpad/policies/reviewpad.yml",
+			wantGitFile: &gitFile{owner: "reviewpad", repo: "policies", path: "reviewpad.yml"},
+		},
+		"when reference has no path": {
+			reference: "reviewpad/policies@main",
+			wantErr:   "loader: git import reviewpad/policies@main must be written as owner/repo/path@ref",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotGitFile, gotErr := parseGitFile(test.reference)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, gotErr, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, gotErr)
+			assert.Equal(t, test.wantGitFile, gotGitFile)
+		})
+	}
+}
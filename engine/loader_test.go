@@ -5,10 +5,16 @@
 package engine_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"testing"
 
+	"github.com/google/go-github/v45/github"
+	"github.com/gorilla/mux"
 	"github.com/jarcoal/httpmock"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/reviewpad/reviewpad/v3/engine"
 	"github.com/reviewpad/reviewpad/v3/engine/testutils"
 	"github.com/reviewpad/reviewpad/v3/utils"
@@ -41,6 +47,16 @@ func TestLoad(t *testing.T) {
 			},
 			wantErr: "Get \"https://foo.bar/nonexistent_file\": file doesn't exist",
 		},
+		"when the file imports an url that is not found": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_import_of_nonexistent_file.yml",
+			httpMockResponders: []httpMockResponder{
+				{
+					url:       "https://foo.bar/nonexistent_file",
+					responder: httpmock.NewStringResponder(404, "Not Found"),
+				},
+			},
+			wantErr: "loader: import https://foo.bar/nonexistent_file failed with status 404",
+		},
 		"when the file imports a file that has a parsing error": {
 			inputReviewpadFilePath: "testdata/loader/reviewpad_with_import_file_with_parse_error.yml",
 			httpMockResponders: []httpMockResponder{
@@ -79,18 +95,64 @@ func TestLoad(t *testing.T) {
 			},
 			wantReviewpadFilePath: "testdata/loader/reviewpad_appended.yml",
 		},
+		"when the file imports functions": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_imported_functions.yml",
+			httpMockResponders: []httpMockResponder{
+				{
+					url:       "https://foo.bar/reviewpad_with_functions.yml",
+					responder: httpmock.NewBytesResponder(200, httpmock.File("testdata/loader/reviewpad_with_functions.yml").Bytes()),
+				},
+			},
+			wantReviewpadFilePath: "testdata/loader/reviewpad_with_imported_functions_after_processing.yml",
+		},
+		"when the file has imports by path": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_path_imports.yml",
+			wantReviewpadFilePath:  "testdata/loader/reviewpad_appended.yml",
+		},
+		"when the file has an import by absolute path": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_absolute_path_import.yml",
+			wantErr:                "loader: import /etc/reviewpad.yml must be a relative path",
+		},
+		"when the file has an import by path outside of its directory": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_path_import_outside_of_dir.yml",
+			wantErr:                "loader: import ../exec/reviewpad_with_valid_group.yml is outside of testdata/loader",
+		},
+		"when the file has pinned imports": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_pinned_imports.yml",
+			httpMockResponders: []httpMockResponder{
+				{
+					url:       "https://foo.bar/reviewpad_with_no_imports.yml",
+					responder: httpmock.NewBytesResponder(200, httpmock.File("testdata/loader/reviewpad_with_no_imports.yml").Bytes()),
+				},
+				{
+					url:       "https://foo.bar/reviewpad_with_one_import.yml",
+					responder: httpmock.NewBytesResponder(200, httpmock.File("testdata/loader/reviewpad_with_one_import.yml").Bytes()),
+				},
+			},
+			wantReviewpadFilePath: "testdata/loader/reviewpad_appended.yml",
+		},
+		"when the file imports a file with an unexpected sha256": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_import_with_unexpected_sha256.yml",
+			httpMockResponders: []httpMockResponder{
+				{
+					url:       "https://foo.bar/reviewpad_with_no_imports.yml",
+					responder: httpmock.NewBytesResponder(200, httpmock.File("testdata/loader/reviewpad_with_no_imports.yml").Bytes()),
+				},
+			},
+			wantErr: "loader: import https://foo.bar/reviewpad_with_no_imports.yml has sha256 e41085db4b486431053944c0bc0ad159b9618b5b9b8273ed20e20e30aedfb9c3 but 0000000000000000000000000000000000000000000000000000000000000000 was expected",
+		},
+		"when the file has an import with both an url and a path": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_ambiguous_import.yml",
+			wantErr:                "loader: an import must have exactly one of url, path or git",
+		},
+		"when the file has git imports without a github client": {
+			inputReviewpadFilePath: "testdata/loader/reviewpad_with_git_imports.yml",
+			wantErr:                "loader: a GitHub client is required to import foo/bar/testdata/loader/imports/reviewpad_with_one_import_by_path.yml",
+		},
 		"when the file has no issues": {
 			inputReviewpadFilePath: "testdata/loader/reviewpad_with_no_imports.yml",
 			wantReviewpadFilePath:  "testdata/loader/reviewpad_with_no_imports.yml",
 		},
-		"when the file requires action transformation": {
-			inputReviewpadFilePath: "testdata/loader/transform/reviewpad_before_action_transform.yml",
-			wantReviewpadFilePath:  "testdata/loader/transform/reviewpad_after_action_transform.yml",
-		},
-		"when the file requires extra action transformation": {
-			inputReviewpadFilePath: "testdata/loader/transform/reviewpad_before_extra_action_transform.yml",
-			wantReviewpadFilePath:  "testdata/loader/transform/reviewpad_after_extra_action_transform.yml",
-		},
 		"when the file has inline rules": {
 			inputReviewpadFilePath: "testdata/loader/process/reviewpad_with_inline_rules.yml",
 			wantReviewpadFilePath:  "testdata/loader/process/reviewpad_with_inline_rules_after_processing.yml",
@@ -128,9 +190,9 @@ func TestLoad(t *testing.T) {
 				assert.FailNow(t, "Error reading reviewpad file: %v", err)
 			}
 
-			gotReviewpadFile, gotErr := engine.Load(reviewpadFileData)
+			gotReviewpadFile, gotErr := engine.LoadWithOptions(context.Background(), reviewpadFileData, engine.LoadOptions{Dir: "testdata/loader"})
 
-			if gotErr != nil && gotErr.Error() != test.wantErr {
+			if (gotErr != nil || test.wantErr != "") && fmt.Sprint(gotErr) != test.wantErr {
 				assert.FailNow(t, "Load() error = %v, wantErr %v", gotErr, test.wantErr)
 			}
 			assert.Equal(t, wantReviewpadFile, gotReviewpadFile)
@@ -138,6 +200,64 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoad_WhenFileHasGitImports(t *testing.T) {
+	// The mocked repository has the files of the current directory.
+	mockedGithubClient := engine.MockGithubClient([]mock.MockBackendOption{
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "v1", r.URL.Query().Get("ref"))
+
+				dir := mux.Vars(r)["path"]
+				entries, err := os.ReadDir(dir)
+				if err != nil {
+					mock.WriteError(w, http.StatusNotFound, err.Error())
+					return
+				}
+
+				contents := make([]*github.RepositoryContent, 0, len(entries))
+				for _, entry := range entries {
+					contents = append(contents, &github.RepositoryContent{
+						Name:        github.String(entry.Name()),
+						DownloadURL: github.String(fmt.Sprintf("https://raw.githubusercontent.com/foo/bar/v1/%v/%v", dir, entry.Name())),
+					})
+				}
+
+				w.Write(mock.MustMarshal(contents))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{
+				Pattern: "/foo/bar/v1/{path:.+}",
+				Method:  "GET",
+			},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(httpmock.File(mux.Vars(r)["path"]).Bytes())
+			}),
+		),
+	})
+
+	wantReviewpadFileData, err := utils.LoadFile("testdata/loader/reviewpad_appended.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	wantReviewpadFile, err := testutils.ParseReviewpadFile(wantReviewpadFileData)
+	if err != nil {
+		assert.FailNow(t, "Error parsing reviewpad file: %v", err)
+	}
+
+	reviewpadFileData, err := utils.LoadFile("testdata/loader/reviewpad_with_git_imports.yml")
+	if err != nil {
+		assert.FailNow(t, "Error reading reviewpad file: %v", err)
+	}
+
+	gotReviewpadFile, err := engine.LoadWithOptions(context.Background(), reviewpadFileData, engine.LoadOptions{Dir: "testdata/loader", GithubClient: mockedGithubClient})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantReviewpadFile, gotReviewpadFile)
+}
+
 func registerHttpResponders(httpMockResponders []httpMockResponder) {
 	for _, httpMockResponder := range httpMockResponders {
 		httpmock.RegisterResponder("GET", httpMockResponder.url, httpMockResponder.responder)
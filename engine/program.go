@@ -4,8 +4,18 @@
 
 package engine
 
+// CompiledStatement is a statement parsed and type checked by the interpreter.
+// Its representation is only known by the interpreter.
+type CompiledStatement interface{}
+
 type Statement struct {
 	code string
+	// path is the location of the statement in the reviewpad file (e.g. workflows[0].then[1])
+	path string
+	// compiled is set when the statement is compiled before the program is built
+	compiled CompiledStatement
+	// ignoreErrors is set when the file, the workflow, the pipeline or the rule of the statement ignores errors
+	ignoreErrors bool
 }
 
 type Program struct {
@@ -14,10 +24,31 @@ type Program struct {
 
 func BuildStatement(code string) *Statement {
 	return &Statement{
-		code,
+		code: code,
+	}
+}
+
+func BuildStatementWithPath(code, path string) *Statement {
+	return &Statement{
+		code: code,
+		path: path,
 	}
 }
 
+func BuildCompiledStatement(code, path string, compiled CompiledStatement) *Statement {
+	return &Statement{
+		code:     code,
+		path:     path,
+		compiled: compiled,
+	}
+}
+
+// WithIgnoreErrors sets whether a failure of the statement is skipped instead of stopping the program.
+func (s *Statement) WithIgnoreErrors(ignoreErrors bool) *Statement {
+	s.ignoreErrors = ignoreErrors
+	return s
+}
+
 func BuildProgram(statements []*Statement) *Program {
 	return &Program{
 		statements,
@@ -28,14 +59,23 @@ func (s *Statement) GetStatementCode() string {
 	return s.code
 }
 
+func (s *Statement) GetStatementPath() string {
+	return s.path
+}
+
+func (s *Statement) GetCompiledStatement() CompiledStatement {
+	return s.compiled
+}
+
+func (s *Statement) IgnoresErrors() bool {
+	return s.ignoreErrors
+}
+
 func (p *Program) GetProgramStatements() []*Statement {
 	return p.statements
 }
 
-func (program *Program) append(workflowActions []string) {
-	for _, workflowAction := range workflowActions {
-		statement := BuildStatement(workflowAction)
-
-		program.statements = append(program.statements, statement)
-	}
+// append adds the statements of a list of actions to the program.
+func (program *Program) append(statements []*Statement) {
+	program.statements = append(program.statements, statements...)
 }
@@ -11,28 +11,17 @@ import (
 )
 
 func TestAppend(t *testing.T) {
-	action := "$actionA()"
-	workflow := PadWorkflow{
-		Name:        "test-workflow-A",
-		Description: "Testing workflow",
-		AlwaysRun:   true,
-		Rules: []PadWorkflowRule{
-			{Rule: "test-rule-A"},
-		},
-		Actions: []string{action},
-	}
-
 	initialStat := BuildStatement("$actionB()")
 
 	programUnderTest := BuildProgram([]*Statement{initialStat})
 
-	addedStat := BuildStatement(action)
+	addedStat := BuildCompiledStatement("$actionA()", "workflows[0].then[0]", "compiled $actionA()")
 	wantProgram := BuildProgram([]*Statement{
 		initialStat,
 		addedStat,
 	})
 
-	programUnderTest.append(workflow.Actions)
+	programUnderTest.append([]*Statement{addedStat})
 
 	assert.Equal(t, wantProgram, programUnderTest)
 }
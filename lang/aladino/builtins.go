@@ -13,12 +13,27 @@ type BuiltIns struct {
 type BuiltInFunction struct {
 	Type Type
 	Code func(e Env, args []Value) (Value, error)
+	// Cost is used to evaluate the cheaper operand of `&&` and `||` first.
+	Cost Cost
+	// Pure built-ins return the same value for the same arguments during a run,
+	// so their results are cached.
+	Pure bool
+	// Total built-ins never fail, so the cheaper operand of `&&` and `||` can be evaluated before them.
+	Total bool
+	// ValidateArg checks the string literal given to the parameter at index param
+	// when the file is checked, e.g. to report an invalid pattern before it is used.
+	ValidateArg func(param int, arg string) error
 }
 
 type BuiltInAction struct {
 	Type     Type
 	Code     func(e Env, args []Value) error
 	Disabled bool
+	// Invalidates lists the pure built-in functions whose cached results
+	// are no longer valid once the action runs.
+	// The responses cached by GetPullRequestReviews and GetPullRequestCommits
+	// are invalidated by the reviews and commits built-ins, respectively.
+	Invalidates []string
 }
 
 func MergeAladinoBuiltIns(builtInsList ...*BuiltIns) *BuiltIns {
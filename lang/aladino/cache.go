@@ -0,0 +1,191 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v45/github"
+	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
+)
+
+// callBuiltIn calls the built-in function name with args.
+// The result of a pure built-in is computed once per run for the same arguments.
+// Errors are not cached so that a failed call can be retried.
+func callBuiltIn(e Env, name string, fn *BuiltInFunction, args []Value) (Value, error) {
+	val, cached, err := callBuiltInWithCache(e, name, fn, args)
+
+	if tracer := e.GetTracer(); tracer != nil {
+		tracer.recordCall(name, args, cached)
+	}
+
+	return val, err
+}
+
+// callBuiltInWithCache also returns whether the result was taken from the cache.
+func callBuiltInWithCache(e Env, name string, fn *BuiltInFunction, args []Value) (Value, bool, error) {
+	cache := e.GetBuiltInsCache()
+	if !fn.Pure || cache == nil {
+		val, err := fn.Code(e, args)
+		return val, false, err
+	}
+
+	key, ok := cacheKey(args)
+	if !ok {
+		val, err := fn.Code(e, args)
+		return val, false, err
+	}
+
+	if val, ok := cache[name][key]; ok {
+		return val, true, nil
+	}
+
+	val, err := fn.Code(e, args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if _, ok := cache[name]; !ok {
+		cache[name] = make(map[string]Value)
+	}
+	cache[name][key] = val
+
+	return val, false, nil
+}
+
+// invalidate removes the cached results of the built-ins.
+func (cache BuiltInsCache) invalidate(names []string) {
+	for _, name := range names {
+		delete(cache, name)
+	}
+}
+
+// invalidate removes the cached responses of the requests.
+func (cache RequestsCache) invalidate(names []string) {
+	for _, name := range names {
+		delete(cache, name)
+	}
+}
+
+// GetPullRequestReviews returns the reviews of the pull request of the environment.
+// The reviews are requested once per run and shared by the built-ins that need them.
+// Actions that change the reviews invalidate them through the "reviews" built-in.
+func GetPullRequestReviews(e Env) ([]*github.PullRequestReview, error) {
+	reviews, err := memoizeRequest(e, "reviews", func(owner, repo string, number int) (interface{}, error) {
+		return e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reviews.([]*github.PullRequestReview), nil
+}
+
+// GetPullRequestCommits returns the commits of the pull request of the environment.
+// The commits are requested once per run and shared by the built-ins that need them.
+// Actions that change the commits invalidate them through the "commits" built-in.
+func GetPullRequestCommits(e Env) ([]*github.RepositoryCommit, error) {
+	commits, err := memoizeRequest(e, "commits", func(owner, repo string, number int) (interface{}, error) {
+		return e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits.([]*github.RepositoryCommit), nil
+}
+
+// memoizeRequest makes the request for the pull request of the environment unless its response is cached under name.
+// Like the results of the built-ins, failed requests are not cached.
+func memoizeRequest(e Env, name string, request func(owner, repo string, number int) (interface{}, error)) (interface{}, error) {
+	cache := e.GetRequestsCache()
+	if response, ok := cache[name]; ok {
+		return response, nil
+	}
+
+	pullRequest := e.GetPullRequest()
+	owner := gh.GetPullRequestBaseOwnerName(pullRequest)
+	repo := gh.GetPullRequestBaseRepoName(pullRequest)
+	number := gh.GetPullRequestNumber(pullRequest)
+
+	response, err := request(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if cache != nil {
+		cache[name] = response
+	}
+
+	return response, nil
+}
+
+// cacheKey encodes the arguments of a built-in call into a string.
+// Functions cannot be encoded, so calls that receive a function are not cached.
+func cacheKey(args []Value) (string, bool) {
+	keys := make([]string, len(args))
+	for i, arg := range args {
+		key, ok := valueKey(arg)
+		if !ok {
+			return "", false
+		}
+		keys[i] = key
+	}
+
+	return strings.Join(keys, ","), true
+}
+
+func valueKey(val Value) (string, bool) {
+	switch val := val.(type) {
+	case *IntValue:
+		return fmt.Sprintf("%v", val.Val), true
+	case *BoolValue:
+		return fmt.Sprintf("%v", val.Val), true
+	case *StringValue:
+		return fmt.Sprintf("%q", val.Val), true
+	case *TimeValue:
+		return fmt.Sprintf("time(%v)", val.Val), true
+	case *DurationValue:
+		return fmt.Sprintf("duration(%v)", val.Val), true
+	case *RegexValue:
+		return fmt.Sprintf("r%q", val.Val.String()), true
+	case *ArrayValue:
+		key, ok := cacheKey(val.Vals)
+		return "[" + key + "]", ok
+	case *RecordValue:
+		return fieldsKey(val.Vals)
+	case *MapValue:
+		return fieldsKey(val.Vals)
+	case *OptionalValue:
+		if !val.IsSet() {
+			return "none", true
+		}
+		key, ok := valueKey(val.Val)
+		return "some(" + key + ")", ok
+	}
+
+	return "", false
+}
+
+func fieldsKey(vals map[string]Value) (string, bool) {
+	names := make([]string, 0, len(vals))
+	for name := range vals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]string, len(names))
+	for i, name := range names {
+		key, ok := valueKey(vals[name])
+		if !ok {
+			return "", false
+		}
+		keys[i] = fmt.Sprintf("%q:%v", name, key)
+	}
+
+	return "{" + strings.Join(keys, ",") + "}", true
+}
@@ -0,0 +1,250 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCountingBuiltIn(pure bool, calls *int) *BuiltInFunction {
+	return &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType()}, BuildIntType()),
+		Code: func(e Env, args []Value) (Value, error) {
+			*calls++
+			return BuildIntValue(*calls), nil
+		},
+		Pure: pure,
+	}
+}
+
+func TestCallBuiltIn_WhenBuiltInIsPure(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	fn := mockCountingBuiltIn(true, &calls)
+
+	firstVal, err := callBuiltIn(mockedEnv, "reviewCount", fn, []Value{BuildStringValue("john")})
+	assert.Nil(t, err)
+
+	secondVal, err := callBuiltIn(mockedEnv, "reviewCount", fn, []Value{BuildStringValue("john")})
+	assert.Nil(t, err)
+
+	otherVal, err := callBuiltIn(mockedEnv, "reviewCount", fn, []Value{BuildStringValue("jane")})
+	assert.Nil(t, err)
+
+	assert.Equal(t, BuildIntValue(1), firstVal)
+	assert.Equal(t, BuildIntValue(1), secondVal)
+	assert.Equal(t, BuildIntValue(2), otherVal)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCallBuiltIn_WhenBuiltInIsNotPure(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	fn := mockCountingBuiltIn(false, &calls)
+
+	for i := 0; i < 2; i++ {
+		_, err := callBuiltIn(mockedEnv, "reviewCount", fn, []Value{BuildStringValue("john")})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestCallBuiltIn_WhenBuiltInFails(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	fn := &BuiltInFunction{
+		Type: BuildFunctionType([]Type{}, BuildIntType()),
+		Code: func(e Env, args []Value) (Value, error) {
+			calls++
+			return nil, fmt.Errorf("request failed")
+		},
+		Pure: true,
+	}
+
+	for i := 0; i < 2; i++ {
+		gotVal, err := callBuiltIn(mockedEnv, "reviewCount", fn, []Value{})
+		assert.Nil(t, gotVal)
+		assert.EqualError(t, err, "request failed")
+	}
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestExec_WhenActionInvalidatesCache(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	mockedEnv.GetBuiltIns().Functions["reviewCount"] = mockCountingBuiltIn(true, &calls)
+	mockedEnv.GetBuiltIns().Functions["commentCount"] = mockCountingBuiltIn(true, &calls)
+	mockedEnv.GetBuiltIns().Actions["emptyAction"].Invalidates = []string{"reviewCount"}
+
+	evalCounts := func() {
+		for _, name := range []string{"reviewCount", "commentCount"} {
+			_, err := BuildFunctionCall(BuildVariable(name), []Expr{BuildStringConst("john")}).Eval(mockedEnv)
+			assert.Nil(t, err)
+		}
+	}
+
+	evalCounts()
+	evalCounts()
+	assert.Equal(t, 2, calls)
+
+	err := BuildFunctionCall(BuildVariable("emptyAction"), []Expr{}).exec(mockedEnv)
+	assert.Nil(t, err)
+
+	evalCounts()
+	assert.Equal(t, 3, calls)
+}
+
+func TestExecProgram_WhenActionInvalidatesCache(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	var gotCounts []Value
+	mockedEnv.GetBuiltIns().Functions["reviewCount"] = mockCountingBuiltIn(true, &calls)
+	mockedEnv.GetBuiltIns().Actions["emptyAction"].Invalidates = []string{"reviewCount"}
+	mockedEnv.GetBuiltIns().Actions["recordCount"] = &BuiltInAction{
+		Type: BuildFunctionType([]Type{BuildIntType()}, nil),
+		Code: func(e Env, args []Value) error {
+			gotCounts = append(gotCounts, args[0])
+			return nil
+		},
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	// the rules are evaluated before the program is executed
+	_, err := mockedInterpreter.EvalExpr("patch", `$reviewCount("john") > 0`)
+	assert.Nil(t, err)
+
+	program := engine.BuildProgram([]*engine.Statement{
+		engine.BuildStatement(`$recordCount($reviewCount("john"))`),
+		engine.BuildStatement(`$emptyAction()`),
+		engine.BuildStatement(`$recordCount($reviewCount("john"))`),
+	})
+
+	exitStatus, err := mockedInterpreter.ExecProgram(program)
+
+	assert.Nil(t, err)
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+	// the read after the action sees a new result instead of the one cached during the evaluation
+	assert.Equal(t, []Value{BuildIntValue(1), BuildIntValue(2)}, gotCounts)
+}
+
+func TestGetPullRequestReviews(t *testing.T) {
+	var requests int
+	mockedEnv := MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					w.Write(mock.MustMarshal([]*github.PullRequestReview{
+						{ID: github.Int64(1), State: github.String("APPROVED")},
+					}))
+				}),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		nil,
+	)
+
+	for i := 0; i < 2; i++ {
+		gotReviews, err := GetPullRequestReviews(mockedEnv)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*github.PullRequestReview{{ID: github.Int64(1), State: github.String("APPROVED")}}, gotReviews)
+	}
+	assert.Equal(t, 1, requests)
+
+	mockedEnv.GetRequestsCache().invalidate([]string{"reviews"})
+
+	_, err := GetPullRequestReviews(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestGetPullRequestCommits_WhenRequestFails(t *testing.T) {
+	var requests int
+	mockedEnv := MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					mock.WriteError(w, http.StatusInternalServerError, "GetPullRequestCommits failed")
+				}),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		nil,
+	)
+
+	for i := 0; i < 2; i++ {
+		gotCommits, err := GetPullRequestCommits(mockedEnv)
+
+		assert.Nil(t, gotCommits)
+		assert.Equal(t, "GetPullRequestCommits failed", err.(*github.ErrorResponse).Message)
+	}
+	// failed requests are not cached
+	assert.Equal(t, 2, requests)
+}
+
+func TestCacheKey(t *testing.T) {
+	tests := map[string]struct {
+		args    []Value
+		wantKey string
+		wantOk  bool
+	}{
+		"when arguments are basic values": {
+			args:    []Value{BuildIntValue(1), BuildStringValue("a,b"), BuildBoolValue(true)},
+			wantKey: `1,"a,b",true`,
+			wantOk:  true,
+		},
+		"when arguments are structured values": {
+			args: []Value{
+				BuildArrayValue([]Value{BuildTimeValue(0), BuildDurationValue(60)}),
+				BuildMapValue(map[string]Value{"b": BuildRegexValue(regexp.MustCompile("^a")), "a": BuildIntValue(1)}),
+			},
+			wantKey: `[time(0),duration(60)],{"a":1,"b":r"^a"}`,
+			wantOk:  true,
+		},
+		"when argument is a function": {
+			args: []Value{
+				BuildStringValue("a"),
+				BuildFunctionValue(func(args []Value) (Value, error) { return BuildTrueValue(), nil }),
+			},
+			wantOk: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotKey, gotOk := cacheKey(test.args)
+
+			assert.Equal(t, test.wantKey, gotKey)
+			assert.Equal(t, test.wantOk, gotOk)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+)
+
+// checker accumulates the errors found while type checking a reviewpad file.
+type checker struct {
+	env    Env
+	file   *engine.ReviewpadFile
+	errors []error
+}
+
+// CheckFile type checks every expression of the reviewpad file against the built-ins.
+// Nothing is evaluated, so the check does not need access to the network.
+// All the errors found are returned, prefixed by the location of the expression in the file.
+func CheckFile(file *engine.ReviewpadFile, builtIns *BuiltIns) []error {
+	c := &checker{
+		env: &BaseEnv{
+			// The constants and functions of the file are added to a copy of the built-ins.
+			BuiltIns:    MergeAladinoBuiltIns(builtIns),
+			RegisterMap: make(RegisterMap),
+		},
+		file: file,
+	}
+
+	c.checkConstants()
+	c.checkFunctions()
+	c.checkGroups()
+	c.checkRules()
+	c.checkWorkflows()
+	c.checkPipelines()
+
+	return c.errors
+}
+
+func (c *checker) report(path string, err error) {
+	c.errors = append(c.errors, fmt.Errorf("%v: %w", path, err))
+}
+
+func (c *checker) checkConstants() {
+	for i, constant := range c.file.Constants {
+		declaredType, _, err := typeCheckConstant(c.env, constant.Name, constant.Type, constant.Value)
+		if err != nil {
+			c.report(fmt.Sprintf("constants[%v]", i), err)
+
+			// Keep the declared type to avoid reporting the same error on every use of the constant.
+			declaredType, err = parseType(constant.Type)
+			if err != nil || isBuiltIn(c.env.GetBuiltIns(), constant.Name) {
+				continue
+			}
+		}
+
+		c.env.GetBuiltIns().Functions[constant.Name] = &BuiltInFunction{Type: declaredType}
+	}
+}
+
+func (c *checker) checkFunctions() {
+	for i, function := range c.file.Functions {
+		path := fmt.Sprintf("functions[%v]", i)
+
+		builtIn, err := buildUserFunction(c.env, function.Name, function.Parameters, function.ReturnType, function.Body)
+		if err != nil {
+			c.report(path, err)
+			continue
+		}
+
+		c.env.GetBuiltIns().Functions[function.Name] = builtIn
+
+		// The body was already parsed successfully.
+		body, _ := Parse(function.Body)
+		c.checkReferences(path, body, function.Body)
+		c.checkArguments(path, body, function.Body)
+	}
+}
+
+func (c *checker) checkGroups() {
+	for i, group := range c.file.Groups {
+		path := fmt.Sprintf("groups[%v].spec", i)
+		source := group.Spec
+		if engine.GroupType(group.Type) == engine.GroupTypeFilter {
+			path = fmt.Sprintf("groups[%v].where", i)
+			source = group.Where
+		}
+
+		expr, err := buildGroupAST(engine.GroupType(group.Type), group.Spec, group.Param, group.Where)
+		if err != nil {
+			c.report(path, err)
+			continue
+		}
+
+		if err := typeCheckGroup(c.env, expr); err != nil {
+			c.report(path, withSource(err, source))
+			continue
+		}
+
+		c.checkReferences(path, expr, source)
+		c.checkArguments(path, expr, source)
+	}
+}
+
+func (c *checker) checkRules() {
+	rulePaths := engine.BuildRulePaths(c.file)
+
+	for _, rule := range c.file.Rules {
+		c.checkCondition(rulePaths[rule.Name], rule.Spec)
+	}
+}
+
+func (c *checker) checkWorkflows() {
+	for i, workflow := range c.file.Workflows {
+		for j, rule := range workflow.Rules {
+			c.checkStatements(fmt.Sprintf("workflows[%v].if[%v].then", i, j), rule.Actions)
+			c.checkStatements(fmt.Sprintf("workflows[%v].if[%v].extra-actions", i, j), rule.ExtraActions)
+		}
+
+		c.checkStatements(fmt.Sprintf("workflows[%v].then", i), workflow.Actions)
+		c.checkStatements(fmt.Sprintf("workflows[%v].else", i), workflow.ElseActions)
+	}
+}
+
+func (c *checker) checkPipelines() {
+	for i, pipeline := range c.file.Pipelines {
+		if pipeline.Trigger != "" {
+			c.checkCondition(fmt.Sprintf("pipelines[%v].trigger", i), pipeline.Trigger)
+		}
+
+		for num, stage := range pipeline.Stages {
+			if stage.Until != "" {
+				c.checkCondition(fmt.Sprintf("pipelines[%v].stages[%v].until", i, num), stage.Until)
+			}
+
+			c.checkStatements(fmt.Sprintf("pipelines[%v].stages[%v].actions", i, num), stage.Actions)
+		}
+	}
+}
+
+func (c *checker) checkCondition(path, source string) {
+	expr, err := compileCondition(c.env, source)
+	if err != nil {
+		c.report(path, err)
+		return
+	}
+
+	c.checkReferences(path, expr, source)
+	c.checkArguments(path, expr, source)
+}
+
+func (c *checker) checkStatements(path string, statements []string) {
+	for i, statement := range statements {
+		statementPath := fmt.Sprintf("%v[%v]", path, i)
+
+		execExpr, err := compileStatement(c.env, statement)
+		if err != nil {
+			c.report(statementPath, err)
+			continue
+		}
+
+		c.checkReferences(statementPath, execExpr.(*FunctionCall), statement)
+		c.checkArguments(statementPath, execExpr.(*FunctionCall), statement)
+	}
+}
+
+// checkReferences checks that the rules and groups referenced by name in expr are defined.
+// Only names given as string literals can be checked.
+func (c *checker) checkReferences(path string, expr Expr, source string) {
+	for _, call := range findCalls(expr, "rule") {
+		if name, ok := literalArgument(call); ok && !c.hasRule(name) {
+			c.report(path, withSource(typeError(call.arguments[0].Pos(), "the rule %v isn't defined", name), source))
+		}
+	}
+
+	for _, call := range findCalls(expr, "group") {
+		if name, ok := literalArgument(call); ok && !c.hasGroup(name) {
+			c.report(path, withSource(typeError(call.arguments[0].Pos(), "the group %v isn't defined", name), source))
+		}
+	}
+}
+
+// checkArguments validates the string literals given to the built-ins that define ValidateArg.
+func (c *checker) checkArguments(path string, expr Expr, source string) {
+	functions := c.env.GetBuiltIns().Functions
+
+	names := make([]string, 0)
+	for name, function := range functions {
+		if function.ValidateArg != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fTy, ok := functions[name].Type.(*FunctionType)
+		if !ok {
+			continue
+		}
+
+		for _, call := range findCalls(expr, name) {
+			// The call was already type checked.
+			bindings, _ := call.bindArgs(fTy)
+			for param, arg := range bindings {
+				if arg == -1 {
+					continue
+				}
+
+				literal, ok := call.arguments[arg].(*StringConst)
+				if !ok {
+					continue
+				}
+
+				if err := functions[name].ValidateArg(param, literal.value); err != nil {
+					c.report(path, withSource(typeError(literal.Pos(), "%v: %v", name, err), source))
+				}
+			}
+		}
+	}
+}
+
+func (c *checker) hasRule(name string) bool {
+	for _, rule := range c.file.Rules {
+		if rule.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *checker) hasGroup(name string) bool {
+	for _, group := range c.file.Groups {
+		if group.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func literalArgument(call *FunctionCall) (string, bool) {
+	if len(call.arguments) != 1 {
+		return "", false
+	}
+
+	arg, ok := call.arguments[0].(*StringConst)
+	if !ok {
+		return "", false
+	}
+
+	return arg.value, true
+}
+
+// findCalls returns all the calls to the function name in expr.
+func findCalls(expr Expr, name string) []*FunctionCall {
+	switch e := expr.(type) {
+	case *FunctionCall:
+		calls := findCallsInList(e.arguments, name)
+		if e.name.ident == name {
+			calls = append([]*FunctionCall{e}, calls...)
+		}
+		return calls
+	case *UnaryOp:
+		return findCalls(e.expr, name)
+	case *BinaryOp:
+		return findCallsInList([]Expr{e.lhs, e.rhs}, name)
+	case *Array:
+		return findCallsInList(e.elems, name)
+	case *Lambda:
+		return findCalls(e.body, name)
+	case *TypedExpr:
+		return findCalls(e.expr, name)
+	case *FieldAccess:
+		return findCalls(e.expr, name)
+	case *Map:
+		exprs := make([]Expr, 0, 2*len(e.entries))
+		for _, entry := range e.entries {
+			exprs = append(exprs, entry.key, entry.value)
+		}
+		return findCallsInList(exprs, name)
+	case *IndexAccess:
+		return findCallsInList([]Expr{e.expr, e.index}, name)
+	case *Conditional:
+		return findCallsInList([]Expr{e.cond, e.thenExpr, e.elseExpr}, name)
+	case *Let:
+		return findCallsInList([]Expr{e.value, e.body}, name)
+	case *Try:
+		return findCallsInList([]Expr{e.expr, e.fallback}, name)
+	}
+
+	return nil
+}
+
+func findCallsInList(exprs []Expr, name string) []*FunctionCall {
+	calls := make([]*FunctionCall, 0)
+	for _, expr := range exprs {
+		calls = append(calls, findCalls(expr, name)...)
+	}
+	return calls
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCheckBuiltIns() *BuiltIns {
+	builtIns := MockBuiltIns()
+	builtIns.Functions["rule"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType()}, BuildBoolType()),
+	}
+	builtIns.Functions["group"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType()}, BuildArrayOfType(BuildStringType())),
+	}
+	return builtIns
+}
+
+func TestCheckFile(t *testing.T) {
+	file := &engine.ReviewpadFile{
+		Constants: []engine.PadConstant{
+			{Name: "limit", Type: "Int", Value: "10"},
+		},
+		Functions: []engine.PadFunction{
+			{
+				Name:       "isBelow",
+				Parameters: []engine.PadFunctionParameter{{Name: "value", Type: "Int"}},
+				Body:       "$value < $limit",
+			},
+		},
+		Groups: []engine.PadGroup{
+			{Name: "seniors", Kind: "developer", Spec: `["john"]`},
+		},
+		Rules: []engine.PadRule{
+			{Name: "small", Kind: "patch", Spec: "$isBelow($zeroConst())"},
+			{Name: "senior", Kind: "patch", Spec: `$rule("small") && $group("seniors") == ["john"]`},
+		},
+		Workflows: []engine.PadWorkflow{
+			{
+				Name:    "check",
+				Rules:   []engine.PadWorkflowRule{{Rule: "senior", ExtraActions: []string{"$emptyAction()"}}},
+				Actions: []string{"$emptyAction()"},
+			},
+		},
+		Pipelines: []engine.PadPipeline{
+			{
+				Name:    "pipeline",
+				Trigger: `$rule("small")`,
+				Stages:  []engine.PadStage{{Actions: []string{"$emptyAction()"}, Until: "true"}},
+			},
+		},
+	}
+
+	gotErrs := CheckFile(file, mockCheckBuiltIns())
+
+	assert.Empty(t, gotErrs)
+}
+
+func TestCheckFile_WhenFileHasErrors(t *testing.T) {
+	file := &engine.ReviewpadFile{
+		Constants: []engine.PadConstant{
+			{Name: "limit", Type: "Int", Value: `"10"`},
+		},
+		Groups: []engine.PadGroup{
+			{Name: "seniors", Kind: "developer", Spec: `1`},
+		},
+		Rules: []engine.PadRule{
+			{Name: "small", Kind: "patch", Spec: "$zeroConst() < $limit"},
+			{Name: "senior", Kind: "patch", Spec: `$rule("large")`},
+			{Name: "inline rule $zeroConst()", Kind: "patch", Spec: "$zeroConst()"},
+		},
+		Workflows: []engine.PadWorkflow{
+			{
+				Name: "check",
+				Rules: []engine.PadWorkflowRule{
+					{Rule: "small", Actions: []string{"$missingAction()"}},
+					{Rule: "inline rule $zeroConst()"},
+				},
+				Actions:     []string{`$emptyAction($group("juniors"))`},
+				ElseActions: []string{`$emptyAction(1)`},
+			},
+		},
+		Pipelines: []engine.PadPipeline{
+			{
+				Name:   "pipeline",
+				Stages: []engine.PadStage{{Actions: []string{"$missingAction()"}, Until: "$limit"}},
+			},
+		},
+	}
+
+	gotErrs := CheckFile(file, mockCheckBuiltIns())
+
+	wantErrs := []string{
+		"constants[0]: type error at line 1, column 1: value of constant limit does not have type Int\n    \"10\"\n    ^",
+		"groups[0].spec: type error at line 1, column 1: expression is not a valid group\n    1\n    ^",
+		"rules[1].spec: type error at line 1, column 7: the rule large isn't defined\n    $rule(\"large\")\n          ^",
+		"workflows[0].if[1]: type error at line 1, column 1: expression is not a condition\n    $zeroConst()\n    ^",
+		"workflows[0].if[0].then[0]: type error at line 1, column 1: no type for built-in missingAction. Please check if the mode in the reviewpad.yml file supports it\n    $missingAction()\n    ^",
+		"workflows[0].then[0]: type error at line 1, column 1: type inference failed: mismatch in arg types on emptyAction\n    $emptyAction($group(\"juniors\"))\n    ^",
+		"workflows[0].else[0]: type error at line 1, column 1: type inference failed: mismatch in arg types on emptyAction\n    $emptyAction(1)\n    ^",
+		"pipelines[0].stages[0].until: type error at line 1, column 1: expression is not a condition\n    $limit\n    ^",
+		"pipelines[0].stages[0].actions[0]: type error at line 1, column 1: no type for built-in missingAction. Please check if the mode in the reviewpad.yml file supports it\n    $missingAction()\n    ^",
+	}
+
+	gotErrMsgs := make([]string, len(gotErrs))
+	for i, err := range gotErrs {
+		gotErrMsgs[i] = err.Error()
+	}
+
+	assert.Equal(t, wantErrs, gotErrMsgs)
+}
+
+func TestCheckFile_WhenPatternIsInvalid(t *testing.T) {
+	builtIns := mockCheckBuiltIns()
+	builtIns.Functions["matches"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType(), BuildStringType()}, BuildBoolType()).WithParamNames("text", "pattern"),
+		ValidateArg: func(param int, arg string) error {
+			if param == 1 && arg == "(" {
+				return fmt.Errorf("invalid pattern %q", arg)
+			}
+			return nil
+		},
+	}
+
+	file := &engine.ReviewpadFile{
+		Rules: []engine.PadRule{
+			{Name: "valid", Kind: "patch", Spec: `$matches("(", "a")`},
+			{Name: "invalid", Kind: "patch", Spec: `$matches("a", "(")`},
+			{Name: "invalid named", Kind: "patch", Spec: `$matches(pattern: "(", text: "a")`},
+			{Name: "not literal", Kind: "patch", Spec: `$matches("a", $returnStr("("))`},
+		},
+		Workflows: []engine.PadWorkflow{
+			{
+				Name:    "check",
+				Rules:   []engine.PadWorkflowRule{{Rule: "valid"}, {Rule: "invalid"}, {Rule: "invalid named"}, {Rule: "not literal"}},
+				Actions: []string{`$emptyAction()`},
+			},
+		},
+	}
+
+	gotErrs := CheckFile(file, builtIns)
+
+	wantErrs := []string{
+		"rules[1].spec: type error at line 1, column 15: matches: invalid pattern \"(\"\n    $matches(\"a\", \"(\")\n                  ^",
+		"rules[2].spec: type error at line 1, column 19: matches: invalid pattern \"(\"\n    $matches(pattern: \"(\", text: \"a\")\n                      ^",
+	}
+
+	gotErrMsgs := make([]string, len(gotErrs))
+	for i, err := range gotErrs {
+		gotErrMsgs[i] = err.Error()
+	}
+
+	assert.Equal(t, wantErrs, gotErrMsgs)
+}
+
+func TestFindCalls(t *testing.T) {
+	expr, err := Parse(`$rule("a") && $any([1], ($i: Int => $rule({"b": "c"}["b"]))) || $group("d") == []`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotCalls := findCalls(expr, "rule")
+
+	assert.Len(t, gotCalls, 2)
+	assert.True(t, BuildStringConst("a").equals(gotCalls[0].arguments[0]))
+
+	_, ok := literalArgument(gotCalls[1])
+	assert.False(t, ok)
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "github.com/reviewpad/reviewpad/v3/engine"
+
+// CompiledExprs holds the typed ASTs of the conditions of a reviewpad file by source
+// and of its groups by name, so that each one is only parsed and type checked once per run.
+// The compiled actions are kept by the statements of the program instead.
+type CompiledExprs struct {
+	conditions map[string]Expr
+	groups     map[string]Expr
+}
+
+func NewCompiledExprs() *CompiledExprs {
+	return &CompiledExprs{
+		conditions: make(map[string]Expr),
+		groups:     make(map[string]Expr),
+	}
+}
+
+// compileCondition parses and type checks an expression that must be of type Bool.
+func compileCondition(env Env, source string) (Expr, error) {
+	compiled := env.GetCompiledExprs()
+	if compiled != nil {
+		if expr, ok := compiled.conditions[source]; ok {
+			return expr, nil
+		}
+	}
+
+	expr, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	exprType, err := TypeInference(env, expr)
+	if err != nil {
+		return nil, withSource(err, source)
+	}
+
+	if exprType.Kind() != BOOL_TYPE {
+		return nil, withSource(typeError(expr.Pos(), "expression is not a condition"), source)
+	}
+
+	if compiled != nil {
+		compiled.conditions[source] = expr
+	}
+
+	return expr, nil
+}
+
+// compileGroup parses and type checks the expression of a group.
+// The expression of a filter group is built from its param and where expressions.
+func compileGroup(env Env, typeOf engine.GroupType, expr, paramExpr, whereExpr string) (Expr, error) {
+	exprAST, err := buildGroupAST(typeOf, expr, paramExpr, whereExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = typeCheckGroup(env, exprAST)
+	if err != nil {
+		source := expr
+		if typeOf == engine.GroupTypeFilter {
+			source = whereExpr
+		}
+
+		return nil, withSource(err, source)
+	}
+
+	return exprAST, nil
+}
+
+// compileStatement parses and type checks a call to an action.
+func compileStatement(env Env, source string) (ExecExpr, error) {
+	expr, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := TypeCheckExec(env, expr)
+	if err != nil {
+		return nil, withSource(err, source)
+	}
+
+	return statement, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "fmt"
+
+// buildConstant evaluates a constant declared in the reviewpad file
+// into a built-in that can be referenced like a variable, e.g. $owners.
+// The value of the constant is computed once, when the constant is declared.
+func buildConstant(env Env, name, typeOf, value string) (*BuiltInFunction, error) {
+	declaredType, valueAST, err := typeCheckConstant(env, name, typeOf, value)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := Eval(env, valueAST)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuiltInFunction{
+		Type: declaredType,
+		Code: func(e Env, args []Value) (Value, error) {
+			return val, nil
+		},
+	}, nil
+}
+
+// typeCheckConstant checks that the value of a constant has the declared type.
+// It returns the declared type and the AST of the value.
+func typeCheckConstant(env Env, name, typeOf, value string) (Type, Expr, error) {
+	if isBuiltIn(env.GetBuiltIns(), name) {
+		return nil, nil, fmt.Errorf("constant %v is already defined", name)
+	}
+
+	declaredType, err := parseType(typeOf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("type of constant %v: %w", name, err)
+	}
+
+	valueAST, err := Parse(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valueType, err := TypeInference(env, valueAST)
+	if err != nil {
+		return nil, nil, withSource(err, value)
+	}
+
+	if !make(substitution).unify(declaredType, valueType) {
+		return nil, nil, withSource(typeError(valueAST.Pos(), "value of constant %v does not have type %v", name, typeOf), value)
+	}
+
+	return declaredType, valueAST, nil
+}
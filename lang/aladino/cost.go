@@ -0,0 +1,121 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+// Cost is a rough estimate of how expensive it is to evaluate an expression.
+type Cost int
+
+const (
+	// COST_LOW is the cost of built-ins that only read data already in memory.
+	COST_LOW Cost = 0
+	// COST_MEDIUM is the cost of built-ins that make requests to the code host.
+	COST_MEDIUM Cost = 1
+	// COST_HIGH is the cost of built-ins that download files, call external services
+	// or whose cost cannot be known in advance.
+	COST_HIGH Cost = 10
+)
+
+// estimateCost adds up the costs of the built-ins referenced in expr.
+func estimateCost(builtIns *BuiltIns, expr Expr) Cost {
+	switch e := expr.(type) {
+	case *Variable:
+		if fn, ok := builtIns.Functions[e.ident]; ok {
+			return fn.Cost
+		}
+	case *FunctionCall:
+		return estimateCost(builtIns, e.name) + estimateCostOfList(builtIns, e.arguments)
+	case *UnaryOp:
+		return estimateCost(builtIns, e.expr)
+	case *BinaryOp:
+		return estimateCost(builtIns, e.lhs) + estimateCost(builtIns, e.rhs)
+	case *Array:
+		return estimateCostOfList(builtIns, e.elems)
+	case *Lambda:
+		return estimateCost(builtIns, e.body)
+	case *TypedExpr:
+		return estimateCost(builtIns, e.expr)
+	case *FieldAccess:
+		return estimateCost(builtIns, e.expr)
+	case *Map:
+		exprs := make([]Expr, 0, 2*len(e.entries))
+		for _, entry := range e.entries {
+			exprs = append(exprs, entry.key, entry.value)
+		}
+		return estimateCostOfList(builtIns, exprs)
+	case *IndexAccess:
+		return estimateCostOfList(builtIns, []Expr{e.expr, e.index})
+	case *Conditional:
+		return estimateCostOfList(builtIns, []Expr{e.cond, e.thenExpr, e.elseExpr})
+	case *Let:
+		return estimateCostOfList(builtIns, []Expr{e.value, e.body})
+	case *Try:
+		return estimateCostOfList(builtIns, []Expr{e.expr, e.fallback})
+	}
+
+	return COST_LOW
+}
+
+func estimateCostOfList(builtIns *BuiltIns, exprs []Expr) Cost {
+	var cost Cost
+	for _, expr := range exprs {
+		cost += estimateCost(builtIns, expr)
+	}
+	return cost
+}
+
+// cannotFail checks if the evaluation of expr is known to succeed, i.e. if it only has literals,
+// bound variables, lambdas and calls to total built-ins.
+// Operations that can fail, such as divisions or index accesses, are not known to succeed.
+func cannotFail(e Env, expr Expr) bool {
+	switch ex := expr.(type) {
+	case *BoolConst, *IntConst, *StringConst, *TimeConst, *DurationConst, *RegexConst, *Lambda:
+		return true
+	case *Variable:
+		if _, ok := e.GetRegisterMap()[ex.ident]; ok {
+			return true
+		}
+
+		fn, ok := e.GetBuiltIns().Functions[ex.ident]
+		return ok && fn.Total
+	case *FunctionCall:
+		// the body of a let-bound lambda can fail
+		if _, ok := e.GetRegisterMap()[ex.name.ident]; ok {
+			return false
+		}
+
+		fn, ok := e.GetBuiltIns().Functions[ex.name.ident]
+		return ok && fn.Total && cannotFailList(e, ex.arguments)
+	case *UnaryOp:
+		return cannotFail(e, ex.expr)
+	case *BinaryOp:
+		switch ex.op.getOperator() {
+		case DIV_OP, MOD_OP:
+			return false
+		}
+
+		return cannotFail(e, ex.lhs) && cannotFail(e, ex.rhs)
+	case *Array:
+		return cannotFailList(e, ex.elems)
+	case *TypedExpr:
+		return cannotFail(e, ex.expr)
+	case *FieldAccess:
+		return cannotFail(e, ex.expr)
+	case *Conditional:
+		return cannotFailList(e, []Expr{ex.cond, ex.thenExpr, ex.elseExpr})
+	case *Try:
+		return cannotFail(e, ex.fallback)
+	}
+
+	return false
+}
+
+func cannotFailList(e Env, exprs []Expr) bool {
+	for _, expr := range exprs {
+		if !cannotFail(e, expr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCost(t *testing.T) {
+	builtIns := MockBuiltIns()
+	builtIns.Functions["reviews"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{}, BuildArrayOfType(BuildReviewType())),
+		Cost: COST_MEDIUM,
+	}
+	builtIns.Functions["hasAnnotation"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType()}, BuildBoolType()),
+		Cost: COST_HIGH,
+	}
+
+	tests := map[string]struct {
+		expr     string
+		wantCost Cost
+	}{
+		"when expression is a constant": {
+			expr:     `1 + 2`,
+			wantCost: COST_LOW,
+		},
+		"when built-in reads data in memory": {
+			expr:     `$size() > 10`,
+			wantCost: COST_LOW,
+		},
+		"when built-in is called inside a lambda": {
+			expr:     `$any([1], ($i: Int => $count($reviews(), ($r: Review => true)) > $i))`,
+			wantCost: COST_MEDIUM,
+		},
+		"when built-ins are combined": {
+			expr:     `!$hasAnnotation("critical") || {"a": $reviews()}["a"] == []`,
+			wantCost: COST_HIGH + COST_MEDIUM,
+		},
+		"when built-ins are in conditionals and let-bindings": {
+			expr:     `let $r = $reviews() in if $hasAnnotation("critical") then $r == [] else true`,
+			wantCost: COST_HIGH + COST_MEDIUM,
+		},
+		"when built-ins are in try expressions": {
+			expr:     `try($hasAnnotation("critical"), $reviews() == [])`,
+			wantCost: COST_HIGH + COST_MEDIUM,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			assert.Equal(t, test.wantCost, estimateCost(builtIns, expr))
+		})
+	}
+}
+
+func TestCannotFail(t *testing.T) {
+	builtIns := MockBuiltIns()
+	builtIns.Functions["isDraft"] = &BuiltInFunction{
+		Type:  BuildFunctionType([]Type{}, BuildBoolType()),
+		Total: true,
+	}
+	builtIns.Functions["reviews"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{}, BuildArrayOfType(BuildReviewType())),
+		Cost: COST_MEDIUM,
+	}
+
+	mockedEnv := MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	tests := map[string]struct {
+		expr           string
+		wantCannotFail bool
+	}{
+		"when expression is a literal": {
+			expr:           `"a" == "b" || 1 + 2 > 2`,
+			wantCannotFail: true,
+		},
+		"when built-in is total": {
+			expr:           `!$isDraft()`,
+			wantCannotFail: true,
+		},
+		"when built-in is not total": {
+			expr:           `$reviews() == []`,
+			wantCannotFail: false,
+		},
+		"when expression is a division": {
+			expr:           `10 / 2 > 1`,
+			wantCannotFail: false,
+		},
+		"when expression is an index access": {
+			expr:           `{"a": 1}["b"] == 1`,
+			wantCannotFail: false,
+		},
+		"when try expression has a total fallback": {
+			expr:           `try($reviews() == [], $isDraft())`,
+			wantCannotFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			assert.Equal(t, test.wantCannotFail, cannotFail(mockedEnv, expr))
+		})
+	}
+}
@@ -27,9 +27,19 @@ type Patch map[string]*File
 
 type RegisterMap map[string]Value
 
+// BuiltInsCache holds the results of the pure built-ins called during a run
+// by built-in name and then by arguments.
+type BuiltInsCache map[string]map[string]Value
+
+// RequestsCache holds the responses of the GitHub requests shared by several built-ins during a run,
+// e.g. the reviews of the pull request.
+type RequestsCache map[string]interface{}
+
 type Env interface {
 	GetBuiltIns() *BuiltIns
+	GetBuiltInsCache() BuiltInsCache
 	GetBuiltInsReportedMessages() map[Severity][]string
+	GetCompiledExprs() *CompiledExprs
 	GetGithubClient() *gh.GithubClient
 	GetCollector() collector.Collector
 	GetCtx() context.Context
@@ -39,11 +49,15 @@ type Env interface {
 	GetPullRequest() *github.PullRequest
 	GetRegisterMap() RegisterMap
 	GetReport() *Report
+	GetRequestsCache() RequestsCache
+	GetTracer() *Tracer
 }
 
 type BaseEnv struct {
 	BuiltIns                 *BuiltIns
+	BuiltInsCache            BuiltInsCache
 	BuiltInsReportedMessages map[Severity][]string
+	CompiledExprs            *CompiledExprs
 	GithubClient             *gh.GithubClient
 	Collector                collector.Collector
 	Ctx                      context.Context
@@ -53,16 +67,27 @@ type BaseEnv struct {
 	PullRequest              *github.PullRequest
 	RegisterMap              RegisterMap
 	Report                   *Report
+	RequestsCache            RequestsCache
+	// Tracer records the evaluation of the expressions when set.
+	Tracer *Tracer
 }
 
 func (e *BaseEnv) GetBuiltIns() *BuiltIns {
 	return e.BuiltIns
 }
 
+func (e *BaseEnv) GetBuiltInsCache() BuiltInsCache {
+	return e.BuiltInsCache
+}
+
 func (e *BaseEnv) GetBuiltInsReportedMessages() map[Severity][]string {
 	return e.BuiltInsReportedMessages
 }
 
+func (e *BaseEnv) GetCompiledExprs() *CompiledExprs {
+	return e.CompiledExprs
+}
+
 func (e *BaseEnv) GetGithubClient() *gh.GithubClient {
 	return e.GithubClient
 }
@@ -99,6 +124,14 @@ func (e *BaseEnv) GetReport() *Report {
 	return e.Report
 }
 
+func (e *BaseEnv) GetRequestsCache() RequestsCache {
+	return e.RequestsCache
+}
+
+func (e *BaseEnv) GetTracer() *Tracer {
+	return e.Tracer
+}
+
 func NewTypeEnv(e Env) TypeEnv {
 	builtInsType := make(map[string]Type)
 	for builtInName, builtInFunction := range e.GetBuiltIns().Functions {
@@ -147,7 +180,9 @@ func NewEvalEnv(
 
 	input := &BaseEnv{
 		BuiltIns:                 builtIns,
+		BuiltInsCache:            make(BuiltInsCache),
 		BuiltInsReportedMessages: make(map[Severity][]string),
+		CompiledExprs:            NewCompiledExprs(),
 		GithubClient:             githubClient,
 		Collector:                collector,
 		Ctx:                      ctx,
@@ -157,6 +192,7 @@ func NewEvalEnv(
 		PullRequest:              pullRequest,
 		RegisterMap:              registerMap,
 		Report:                   report,
+		RequestsCache:            make(RequestsCache),
 	}
 
 	return input, nil
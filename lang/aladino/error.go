@@ -0,0 +1,115 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PARSE_ERROR string = "parse error"
+	TYPE_ERROR  string = "type error"
+)
+
+// Position is a location in the source of an Aladino expression.
+// Both lines and columns start at 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (pos Position) IsValid() bool {
+	return pos.Line > 0
+}
+
+func (pos Position) String() string {
+	return fmt.Sprintf("line %v, column %v", pos.Line, pos.Column)
+}
+
+// Error is an error found while processing the source of an Aladino expression.
+// When the position and the source are known, the error message shows where
+// the error happened with a snippet of the offending line.
+type Error struct {
+	Kind   string
+	Pos    Position
+	Msg    string
+	Source string
+}
+
+func parseError(pos Position, source string, format string, a ...interface{}) *Error {
+	return &Error{
+		Kind:   PARSE_ERROR,
+		Pos:    pos,
+		Msg:    fmt.Sprintf(format, a...),
+		Source: source,
+	}
+}
+
+func typeError(pos Position, format string, a ...interface{}) *Error {
+	return &Error{
+		Kind: TYPE_ERROR,
+		Pos:  pos,
+		Msg:  fmt.Sprintf(format, a...),
+	}
+}
+
+func (e *Error) Error() string {
+	if !e.Pos.IsValid() {
+		return e.Msg
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%v at %v: %v", e.Kind, e.Pos, e.Msg))
+
+	if snippet := e.snippet(); snippet != "" {
+		sb.WriteString("\n")
+		sb.WriteString(snippet)
+	}
+
+	return sb.String()
+}
+
+// snippet returns the source line where the error happened
+// followed by a caret pointing at the error column.
+func (e *Error) snippet() string {
+	lines := strings.Split(e.Source, "\n")
+	if e.Source == "" || e.Pos.Line > len(lines) {
+		return ""
+	}
+
+	line := []rune(lines[e.Pos.Line-1])
+
+	// Keep the tabs of the source line so the caret stays aligned.
+	var caret strings.Builder
+	for i := 0; i < e.Pos.Column-1 && i < len(line); i++ {
+		if line[i] == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+
+	return fmt.Sprintf("    %v\n    %v", string(line), caret.String())
+}
+
+// withSource attaches the source of the expression to an Aladino error
+// so that its message can show where the error happened.
+// Errors that are not Aladino errors or that already have a source are returned untouched.
+func withSource(err error, source string) error {
+	aladinoErr, ok := err.(*Error)
+	if !ok || aladinoErr.Source != "" {
+		return err
+	}
+
+	return &Error{
+		Kind:   aladinoErr.Kind,
+		Pos:    aladinoErr.Pos,
+		Msg:    aladinoErr.Msg,
+		Source: source,
+	}
+}
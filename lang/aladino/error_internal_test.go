@@ -0,0 +1,55 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_WhenPositionIsUnknown(t *testing.T) {
+	err := typeError(Position{}, "type inference failed")
+
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestError_WhenSourceIsUnknown(t *testing.T) {
+	err := typeError(Position{2, 3}, "type inference failed")
+
+	assert.EqualError(t, err, "type error at line 2, column 3: type inference failed")
+}
+
+func TestError_WhenSourceHasMultipleLines(t *testing.T) {
+	err := parseError(Position{2, 8}, "$isDraft() &&\n\t$size(", "unexpected end of input")
+
+	assert.EqualError(t, err, "parse error at line 2, column 8: unexpected end of input\n    \t$size(\n    \t      ^")
+}
+
+func TestWithSource_WhenErrorIsNotAladinoError(t *testing.T) {
+	err := errors.New("some error")
+
+	assert.Equal(t, err, withSource(err, "$size()"))
+}
+
+func TestWithSource_WhenErrorHasSource(t *testing.T) {
+	err := parseError(Position{1, 1}, "$size(", "unexpected end of input")
+
+	assert.Equal(t, err, withSource(err, "$size() > 1"))
+}
+
+func TestWithSource(t *testing.T) {
+	err := typeError(Position{1, 9}, "type inference failed")
+
+	wantErr := &Error{
+		Kind:   TYPE_ERROR,
+		Pos:    Position{1, 9},
+		Msg:    "type inference failed",
+		Source: `$size() + "a"`,
+	}
+
+	assert.Equal(t, wantErr, withSource(err, `$size() + "a"`))
+}
@@ -6,10 +6,12 @@ package aladino
 
 import (
 	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/utils"
 )
 
 func (u *UnaryOp) Eval(e Env) (Value, error) {
-	exprValue, exprErr := u.expr.Eval(e)
+	exprValue, exprErr := evalExpr(e, u.expr)
 	if exprErr != nil {
 		return nil, exprErr
 	}
@@ -20,25 +22,79 @@ func (u *UnaryOp) Eval(e Env) (Value, error) {
 }
 
 func (b *BinaryOp) Eval(e Env) (Value, error) {
-	leftValue, leftErr := b.lhs.Eval(e)
+	switch b.op.getOperator() {
+	case AND_OP, OR_OP:
+		return b.evalLazily(e)
+	}
+
+	leftValue, leftErr := evalExpr(e, b.lhs)
 	if leftErr != nil {
 		return nil, leftErr
 	}
 
-	rightValue, rightErr := b.rhs.Eval(e)
+	rightValue, rightErr := evalExpr(e, b.rhs)
 	if rightErr != nil {
 		return nil, rightErr
 	}
 
-	if !leftValue.HasKindOf(rightValue.Kind()) {
+	if !haveCompatibleKinds(leftValue, rightValue) {
 		return nil, fmt.Errorf("eval: left and right operand have different kinds")
 	}
 
 	operator := b.op
 
+	switch operator.getOperator() {
+	case DIV_OP, MOD_OP:
+		if rightValue.Equals(BuildIntValue(0)) {
+			return nil, fmt.Errorf("eval: division by zero")
+		}
+	}
+
 	return operator.Eval(leftValue, rightValue), nil
 }
 
+// evalLazily evaluates `&&` and `||` without evaluating the second operand
+// when the first one already decides the result.
+// The right operand goes first when it is cheaper than the left one and the left one cannot fail.
+// Both operators are commutative so this does not change the result of the expression,
+// while a left operand that can fail must still report its error.
+// If the right operand fails, the operands are evaluated in the written order
+// so that guards such as `$x != 0 && 10 / $x > 1` keep working.
+func (b *BinaryOp) evalLazily(e Env) (Value, error) {
+	// A false operand decides `&&` while a true operand decides `||`.
+	decisive := b.op.getOperator() == OR_OP
+
+	builtIns := e.GetBuiltIns()
+	if estimateCost(builtIns, b.rhs) < estimateCost(builtIns, b.lhs) && cannotFail(e, b.lhs) {
+		rightValue, rightErr := evalExpr(e, b.rhs)
+		if rightErr == nil && rightValue.(*BoolValue).Val == decisive {
+			return rightValue, nil
+		}
+
+		leftValue, leftErr := evalExpr(e, b.lhs)
+		if leftErr != nil {
+			return nil, leftErr
+		}
+
+		if rightErr != nil && leftValue.(*BoolValue).Val != decisive {
+			return nil, rightErr
+		}
+
+		return leftValue, nil
+	}
+
+	leftValue, leftErr := evalExpr(e, b.lhs)
+	if leftErr != nil {
+		return nil, leftErr
+	}
+
+	if leftValue.(*BoolValue).Val == decisive {
+		return leftValue, nil
+	}
+
+	return evalExpr(e, b.rhs)
+}
+
 func (v *Variable) Eval(e Env) (Value, error) {
 	variableName := v.ident
 
@@ -51,7 +107,7 @@ func (v *Variable) Eval(e Env) (Value, error) {
 		return nil, fmt.Errorf("eval: failure on %v", variableName)
 	}
 
-	return fn.Code(e, []Value{})
+	return callBuiltIn(e, variableName, fn, []Value{})
 }
 
 func (b *BoolConst) Eval(e Env) (Value, error) {
@@ -62,6 +118,47 @@ func (c *StringConst) Eval(e Env) (Value, error) {
 	return BuildStringValue(c.value), nil
 }
 
+// haveCompatibleKinds checks if the operands of a binary operation can be combined.
+// Besides values of the same kind, timestamps can be shifted by durations
+// and strings can be matched against regular expressions.
+func haveCompatibleKinds(lhs, rhs Value) bool {
+	switch {
+	case lhs.HasKindOf(rhs.Kind()):
+		return true
+	case lhs.HasKindOf(TIME_VALUE) && rhs.HasKindOf(DURATION_VALUE):
+		return true
+	case lhs.HasKindOf(DURATION_VALUE) && rhs.HasKindOf(TIME_VALUE):
+		return true
+	// timestamps are compared with Unix seconds
+	case lhs.HasKindOf(TIME_VALUE) && rhs.HasKindOf(INT_VALUE), lhs.HasKindOf(INT_VALUE) && rhs.HasKindOf(TIME_VALUE):
+		return true
+	case lhs.HasKindOf(STRING_VALUE) && rhs.HasKindOf(REGEX_VALUE):
+		return true
+	// optional values are compared with the values of their element type
+	case lhs.HasKindOf(OPTIONAL_VALUE) || rhs.HasKindOf(OPTIONAL_VALUE):
+		return true
+	}
+
+	return false
+}
+
+func (r *RegexConst) Eval(e Env) (Value, error) {
+	regex, err := r.compile()
+	if err != nil {
+		return nil, fmt.Errorf("eval: invalid regular expression %q: %v", r.pattern, err)
+	}
+
+	return BuildRegexValue(regex), nil
+}
+
+func (t *TimeConst) Eval(e Env) (Value, error) {
+	return BuildTimeValue(t.value), nil
+}
+
+func (d *DurationConst) Eval(e Env) (Value, error) {
+	return BuildDurationValue(d.value), nil
+}
+
 func (i *IntConst) Eval(e Env) (Value, error) {
 	return BuildIntValue(i.value), nil
 }
@@ -69,7 +166,7 @@ func (i *IntConst) Eval(e Env) (Value, error) {
 func (fc *FunctionCall) Eval(e Env) (Value, error) {
 	args := make([]Value, len(fc.arguments))
 	for i, elem := range fc.arguments {
-		value, err := elem.Eval(e)
+		value, err := evalExpr(e, elem)
 
 		if err != nil {
 			return nil, err
@@ -78,42 +175,290 @@ func (fc *FunctionCall) Eval(e Env) (Value, error) {
 		args[i] = value
 	}
 
+	// lambdas bound by let are called like built-ins, which they hide
+	if fnVal, ok := e.GetRegisterMap()[fc.name.ident].(*FunctionValue); ok {
+		return fnVal.Fn(args)
+	}
+
 	fn, ok := e.GetBuiltIns().Functions[fc.name.ident]
 	if !ok {
 		return nil, fmt.Errorf("eval: failure on %v", fc.name.ident)
 	}
 
-	return fn.Code(e, args)
+	if fnType, ok := fn.Type.(*FunctionType); ok {
+		arrangedArgs, err := fc.arrangeArgs(fnType, args)
+		if err != nil {
+			return nil, err
+		}
+
+		args = arrangedArgs
+	}
+
+	return callBuiltIn(e, fc.name.ident, fn, args)
 }
 
+// arrangeArgs puts the arguments of the call in the order of the parameters of the function
+// and fills in the default values of the arguments left out.
+func (fc *FunctionCall) arrangeArgs(fTy *FunctionType, args []Value) ([]Value, error) {
+	if fc.argNames == nil {
+		return fTy.completeArgs(args), nil
+	}
+
+	bindings, err := fc.bindArgs(fTy)
+	if err != nil {
+		return nil, err
+	}
+
+	nRequired := len(fTy.paramTypes) - len(fTy.defaults)
+
+	arrangedArgs := make([]Value, len(bindings))
+	for param, arg := range bindings {
+		if arg == -1 {
+			arrangedArgs[param] = fTy.defaults[param-nRequired]
+		} else {
+			arrangedArgs[param] = args[arg]
+		}
+	}
+
+	return fTy.completeArgs(arrangedArgs), nil
+}
+
+// Eval returns the lambda as a function value.
+// The function captures the values that the variables of its body have when the lambda is evaluated,
+// e.g. the ones bound by an enclosing let, so that it can be called once they are out of scope.
 func (lambda *Lambda) Eval(e Env) (Value, error) {
-	fn := func(args []Value) Value {
-		// TODO: We need to deep copy the register map and use in the eval at L79
-		for i, elem := range lambda.parameters {
-			paramIdent := elem.(*TypedExpr).expr.(*Variable).ident
+	registerMap := e.GetRegisterMap()
+	paramIdents := lambda.paramIdents()
 
-			e.GetRegisterMap()[paramIdent] = args[i]
+	captured := make(RegisterMap)
+	for _, ident := range variableIdents(lambda.body) {
+		if utils.ElementOf(paramIdents, ident) {
+			continue
 		}
 
-		fnVal, err := lambda.body.Eval(e)
-		if err != nil {
-			return nil
+		if val, ok := registerMap[ident]; ok {
+			captured[ident] = val
 		}
+	}
 
-		return fnVal
+	fn := func(args []Value) (Value, error) {
+		return lambda.apply(e, captured, args)
 	}
 
 	return BuildFunctionValue(fn), nil
 }
 
+// apply evaluates the body of the lambda with its captured variables bound to their values
+// and its parameters bound to args.
+// They are only visible while the body is evaluated:
+// the register map is restored once the evaluation is done.
+func (lambda *Lambda) apply(e Env, captured RegisterMap, args []Value) (Value, error) {
+	idents := lambda.paramIdents()
+	for ident := range captured {
+		idents = append(idents, ident)
+	}
+
+	registerMap := e.GetRegisterMap()
+	defer registerMap.restore(idents)()
+
+	for ident, val := range captured {
+		registerMap[ident] = val
+	}
+
+	for i, paramIdent := range lambda.paramIdents() {
+		registerMap[paramIdent] = args[i]
+	}
+
+	return evalExpr(e, lambda.body)
+}
+
+// variableIdents returns the identifiers of the variables and of the functions called in expr.
+func variableIdents(expr Expr) []string {
+	switch e := expr.(type) {
+	case *Variable:
+		return []string{e.ident}
+	case *FunctionCall:
+		return append([]string{e.name.ident}, variableIdentsOfList(e.arguments)...)
+	case *UnaryOp:
+		return variableIdents(e.expr)
+	case *BinaryOp:
+		return variableIdentsOfList([]Expr{e.lhs, e.rhs})
+	case *Array:
+		return variableIdentsOfList(e.elems)
+	case *Lambda:
+		return variableIdents(e.body)
+	case *TypedExpr:
+		return variableIdents(e.expr)
+	case *FieldAccess:
+		return variableIdents(e.expr)
+	case *Map:
+		exprs := make([]Expr, 0, 2*len(e.entries))
+		for _, entry := range e.entries {
+			exprs = append(exprs, entry.key, entry.value)
+		}
+		return variableIdentsOfList(exprs)
+	case *IndexAccess:
+		return variableIdentsOfList([]Expr{e.expr, e.index})
+	case *Conditional:
+		return variableIdentsOfList([]Expr{e.cond, e.thenExpr, e.elseExpr})
+	case *Let:
+		return variableIdentsOfList([]Expr{e.value, e.body})
+	case *Try:
+		return variableIdentsOfList([]Expr{e.expr, e.fallback})
+	}
+
+	return nil
+}
+
+func variableIdentsOfList(exprs []Expr) []string {
+	idents := make([]string, 0)
+	for _, expr := range exprs {
+		idents = append(idents, variableIdents(expr)...)
+	}
+	return idents
+}
+
+// restore returns a function that restores the values of the identifiers
+// to the ones they have now. It is used to leave the scope where they are redefined.
+func (registerMap RegisterMap) restore(idents []string) func() {
+	saved := make(RegisterMap)
+	for _, ident := range idents {
+		if val, ok := registerMap[ident]; ok {
+			saved[ident] = val
+		}
+	}
+
+	return func() {
+		for _, ident := range idents {
+			if val, ok := saved[ident]; ok {
+				registerMap[ident] = val
+			} else {
+				delete(registerMap, ident)
+			}
+		}
+	}
+}
+
 func (te *TypedExpr) Eval(e Env) (Value, error) {
-	return te.expr.Eval(e)
+	return evalExpr(e, te.expr)
+}
+
+func (fa *FieldAccess) Eval(e Env) (Value, error) {
+	value, err := evalExpr(e, fa.expr)
+	if err != nil {
+		return nil, err
+	}
+
+	record, ok := value.(*RecordValue)
+	if !ok {
+		return nil, fmt.Errorf("eval: field %v of a value that is not a record", fa.field)
+	}
+
+	fieldValue, ok := record.Vals[fa.field]
+	if !ok {
+		return nil, fmt.Errorf("eval: unknown field %v", fa.field)
+	}
+
+	return fieldValue, nil
+}
+
+func (m *Map) Eval(e Env) (Value, error) {
+	values := make(map[string]Value, len(m.entries))
+	for _, entry := range m.entries {
+		key, err := evalExpr(e, entry.key)
+		if err != nil {
+			return nil, err
+		}
+
+		keyValue := key.(*StringValue).Val
+		if _, ok := values[keyValue]; ok {
+			return nil, fmt.Errorf("eval: duplicate key %q in map", keyValue)
+		}
+
+		value, err := evalExpr(e, entry.value)
+		if err != nil {
+			return nil, err
+		}
+
+		values[keyValue] = value
+	}
+
+	return BuildMapValue(values), nil
+}
+
+func (ia *IndexAccess) Eval(e Env) (Value, error) {
+	value, err := evalExpr(e, ia.expr)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := evalExpr(e, ia.index)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := value.(type) {
+	case *ArrayValue:
+		i := index.(*IntValue).Val
+		if i < 0 || i >= len(value.Vals) {
+			return nil, fmt.Errorf("eval: index %v out of range", i)
+		}
+
+		return value.Vals[i], nil
+	case *MapValue:
+		key := index.(*StringValue).Val
+		keyValue, ok := value.Vals[key]
+		if !ok {
+			return nil, fmt.Errorf("eval: key %q not found", key)
+		}
+
+		return keyValue, nil
+	}
+
+	return nil, fmt.Errorf("eval: index of a value that is not an array or a map")
+}
+
+func (c *Conditional) Eval(e Env) (Value, error) {
+	cond, err := evalExpr(e, c.cond)
+	if err != nil {
+		return nil, err
+	}
+
+	if cond.(*BoolValue).Val {
+		return evalExpr(e, c.thenExpr)
+	}
+
+	return evalExpr(e, c.elseExpr)
+}
+
+func (l *Let) Eval(e Env) (Value, error) {
+	value, err := evalExpr(e, l.value)
+	if err != nil {
+		return nil, err
+	}
+
+	registerMap := e.GetRegisterMap()
+	defer registerMap.restore([]string{l.variable.ident})()
+
+	registerMap[l.variable.ident] = value
+
+	return evalExpr(e, l.body)
+}
+
+func (t *Try) Eval(e Env) (Value, error) {
+	value, err := evalExpr(e, t.expr)
+	if err != nil {
+		execLogf("\tusing fallback of try expression: %v", err)
+		return evalExpr(e, t.fallback)
+	}
+
+	return value, nil
 }
 
 func (a *Array) Eval(e Env) (Value, error) {
 	values := make([]Value, len(a.elems))
 	for i, elem := range a.elems {
-		value, err := elem.Eval(e)
+		value, err := evalExpr(e, elem)
 
 		if err != nil {
 			return nil, err
@@ -126,7 +471,7 @@ func (a *Array) Eval(e Env) (Value, error) {
 }
 
 func Eval(env Env, expr Expr) (Value, error) {
-	val, err := expr.Eval(env)
+	val, err := evalExpr(env, expr)
 
 	if err != nil {
 		return nil, err
@@ -138,7 +483,7 @@ func Eval(env Env, expr Expr) (Value, error) {
 // EvalCondition evaluates a boolean expression
 // Pre-condition: the type of expr is BoolType
 func EvalCondition(env Env, expr Expr) (bool, error) {
-	boolVal, err := expr.Eval(env)
+	boolVal, err := evalExpr(env, expr)
 
 	if err != nil {
 		return false, err
@@ -151,12 +496,36 @@ func (op *NotOp) Eval(exprVal Value) Value {
 	return BuildBoolValue(!exprVal.(*BoolValue).Val)
 }
 
+func (op *NegOp) Eval(exprVal Value) Value {
+	if duration, ok := exprVal.(*DurationValue); ok {
+		return BuildDurationValue(-duration.Val)
+	}
+
+	return BuildIntValue(-exprVal.(*IntValue).Val)
+}
+
 func (op *EqOp) Eval(lhs, rhs Value) Value {
-	return BuildBoolValue(lhs.Equals(rhs))
+	return BuildBoolValue(equalValues(lhs, rhs))
 }
 
 func (op *NeqOp) Eval(lhs, rhs Value) Value {
-	return BuildBoolValue(!lhs.Equals(rhs))
+	return BuildBoolValue(!equalValues(lhs, rhs))
+}
+
+// equalValues checks if the values are equal.
+// A value compared with an optional value is lifted to $some of it.
+func equalValues(lhs, rhs Value) bool {
+	_, lhsIsOptional := lhs.(*OptionalValue)
+	_, rhsIsOptional := rhs.(*OptionalValue)
+
+	switch {
+	case lhsIsOptional && !rhsIsOptional:
+		return lhs.Equals(BuildSomeValue(rhs))
+	case !lhsIsOptional && rhsIsOptional:
+		return BuildSomeValue(lhs).Equals(rhs)
+	}
+
+	return lhs.Equals(rhs)
 }
 
 func (op *AndOp) Eval(lhs, rhs Value) Value {
@@ -173,30 +542,119 @@ func (op *OrOp) Eval(lhs, rhs Value) Value {
 	return BuildBoolValue(leftValue || rightValue)
 }
 
+// ordinal returns the integer by which integers, timestamps and durations are ordered.
+func ordinal(val Value) int {
+	switch val := val.(type) {
+	case *TimeValue:
+		return val.Val
+	case *DurationValue:
+		return val.Val
+	}
+
+	return val.(*IntValue).Val
+}
+
 func (op *LessThanOp) Eval(lhs, rhs Value) Value {
-	leftValue := lhs.(*IntValue).Val
-	rightValue := rhs.(*IntValue).Val
+	leftValue := ordinal(lhs)
+	rightValue := ordinal(rhs)
 
 	return BuildBoolValue(leftValue < rightValue)
 }
 
 func (op *LessEqThanOp) Eval(lhs, rhs Value) Value {
-	leftValue := lhs.(*IntValue).Val
-	rightValue := rhs.(*IntValue).Val
+	leftValue := ordinal(lhs)
+	rightValue := ordinal(rhs)
 
 	return BuildBoolValue(leftValue <= rightValue)
 }
 
 func (op *GreaterThanOp) Eval(lhs, rhs Value) Value {
-	leftValue := lhs.(*IntValue).Val
-	rightValue := rhs.(*IntValue).Val
+	leftValue := ordinal(lhs)
+	rightValue := ordinal(rhs)
 
 	return BuildBoolValue(leftValue > rightValue)
 }
 
 func (op *GreaterEqThanOp) Eval(lhs, rhs Value) Value {
+	leftValue := ordinal(lhs)
+	rightValue := ordinal(rhs)
+
+	return BuildBoolValue(leftValue >= rightValue)
+}
+
+func (op *PlusOp) Eval(lhs, rhs Value) Value {
+	switch lhs := lhs.(type) {
+	case *StringValue:
+		return BuildStringValue(lhs.Val + rhs.(*StringValue).Val)
+	case *TimeValue:
+		return BuildTimeValue(lhs.Val + rhs.(*DurationValue).Val)
+	case *DurationValue:
+		if rhs, ok := rhs.(*TimeValue); ok {
+			return BuildTimeValue(lhs.Val + rhs.Val)
+		}
+
+		return BuildDurationValue(lhs.Val + rhs.(*DurationValue).Val)
+	}
+
 	leftValue := lhs.(*IntValue).Val
 	rightValue := rhs.(*IntValue).Val
 
-	return BuildBoolValue(leftValue >= rightValue)
+	return BuildIntValue(leftValue + rightValue)
+}
+
+func (op *MinusOp) Eval(lhs, rhs Value) Value {
+	switch lhs := lhs.(type) {
+	case *TimeValue:
+		if rhs, ok := rhs.(*TimeValue); ok {
+			return BuildDurationValue(lhs.Val - rhs.Val)
+		}
+
+		return BuildTimeValue(lhs.Val - rhs.(*DurationValue).Val)
+	case *DurationValue:
+		return BuildDurationValue(lhs.Val - rhs.(*DurationValue).Val)
+	}
+
+	leftValue := lhs.(*IntValue).Val
+	rightValue := rhs.(*IntValue).Val
+
+	return BuildIntValue(leftValue - rightValue)
+}
+
+func (op *MultOp) Eval(lhs, rhs Value) Value {
+	leftValue := lhs.(*IntValue).Val
+	rightValue := rhs.(*IntValue).Val
+
+	return BuildIntValue(leftValue * rightValue)
+}
+
+// DivOp performs integer division.
+// Pre-condition: rhs is not zero
+func (op *DivOp) Eval(lhs, rhs Value) Value {
+	leftValue := lhs.(*IntValue).Val
+	rightValue := rhs.(*IntValue).Val
+
+	return BuildIntValue(leftValue / rightValue)
+}
+
+// ModOp computes the remainder of the integer division.
+// Pre-condition: rhs is not zero
+func (op *ModOp) Eval(lhs, rhs Value) Value {
+	leftValue := lhs.(*IntValue).Val
+	rightValue := rhs.(*IntValue).Val
+
+	return BuildIntValue(leftValue % rightValue)
+}
+
+func (op *MatchOp) Eval(lhs, rhs Value) Value {
+	leftValue := lhs.(*StringValue).Val
+	rightValue := rhs.(*RegexValue).Val
+
+	return BuildBoolValue(rightValue.MatchString(leftValue))
+}
+
+func (op *NotMatchOp) Eval(lhs, rhs Value) Value {
+	leftValue := lhs.(*StringValue).Val
+	rightValue := rhs.(*RegexValue).Val
+
+	return BuildBoolValue(!rightValue.MatchString(leftValue))
 }
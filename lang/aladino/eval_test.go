@@ -5,6 +5,7 @@
 package aladino_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
@@ -260,6 +261,84 @@ func TestEval_OnFunctionCall_WhenFunctionIsABuiltIn(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestEval_OnFunctionCall_WhenArgumentsAreCompleted(t *testing.T) {
+	echoArgs := func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+		return aladino.BuildArrayValue(args), nil
+	}
+	builtIns := &aladino.BuiltIns{
+		Functions: map[string]*aladino.BuiltInFunction{
+			"withDefault": {
+				Type: aladino.BuildFunctionTypeWithDefaults(
+					[]aladino.Type{aladino.BuildStringType(), aladino.BuildIntType()},
+					[]aladino.Value{aladino.BuildIntValue(99)},
+					aladino.BuildArrayOfType(aladino.BuildStringType()),
+				).WithParamNames("name", "count"),
+				Code: echoArgs,
+			},
+			"variadic": {
+				Type: aladino.BuildVariadicFunctionType(
+					[]aladino.Type{aladino.BuildStringType()},
+					aladino.BuildStringType(),
+					aladino.BuildArrayOfType(aladino.BuildStringType()),
+				),
+				Code: echoArgs,
+			},
+		},
+		Actions: map[string]*aladino.BuiltInAction{},
+	}
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+	}{
+		"when the optional argument is left out": {
+			expr:    `$withDefault("a")`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildIntValue(99)}),
+		},
+		"when the optional argument is given": {
+			expr:    `$withDefault("a", 1)`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildIntValue(1)}),
+		},
+		"when there are no variadic arguments": {
+			expr: `$variadic("a")`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{
+				aladino.BuildStringValue("a"),
+				aladino.BuildArrayValue([]aladino.Value{}),
+			}),
+		},
+		"when the arguments are named": {
+			expr:    `$withDefault(count: 1, name: "a")`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildIntValue(1)}),
+		},
+		"when the optional named argument is left out": {
+			expr:    `$withDefault(name: "a")`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildIntValue(99)}),
+		},
+		"when there are variadic arguments": {
+			expr: `$variadic("a", "b", "c")`,
+			wantVal: aladino.BuildArrayValue([]aladino.Value{
+				aladino.BuildStringValue("a"),
+				aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("b"), aladino.BuildStringValue("c")}),
+			}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fc, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := fc.Eval(mockedEnv)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
 func TestEval_OnLambda_WhenLambdaBodyEvalFails(t *testing.T) {
 	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
 
@@ -272,10 +351,12 @@ func TestEval_OnLambda_WhenLambdaBodyEvalFails(t *testing.T) {
 
 	gotFn, err := lambda.Eval(mockedEnv)
 
-	gotVal := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{})
-
 	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{})
+
 	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: failure on nonBuiltIn")
 }
 
 func TestEval_OnLambda(t *testing.T) {
@@ -294,7 +375,9 @@ func TestEval_OnLambda(t *testing.T) {
 
 	gotFn, err := lambda.Eval(mockedEnv)
 
-	gotVal := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(0)})
+	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(0)})
 
 	wantVal := aladino.BuildTrueValue()
 
@@ -449,6 +532,39 @@ func TestEval_OnNeqOp(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestEval_OnEqOp_WhenOptionalValueIsComparedWithPlainValue(t *testing.T) {
+	tests := map[string]struct {
+		lhs     aladino.Value
+		rhs     aladino.Value
+		wantVal aladino.Value
+	}{
+		"when optional value is present and equal": {
+			lhs:     aladino.BuildSomeValue(aladino.BuildStringValue("success")),
+			rhs:     aladino.BuildStringValue("success"),
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when optional value is present and different": {
+			lhs:     aladino.BuildStringValue("success"),
+			rhs:     aladino.BuildSomeValue(aladino.BuildStringValue("failure")),
+			wantVal: aladino.BuildFalseValue(),
+		},
+		"when optional value is absent": {
+			lhs:     aladino.BuildNoneValue(),
+			rhs:     aladino.BuildStringValue("success"),
+			wantVal: aladino.BuildFalseValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			eqOp := &aladino.EqOp{}
+			gotVal := eqOp.Eval(test.lhs, test.rhs)
+
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
 func TestEval_OnAndOp(t *testing.T) {
 	andOp := &aladino.AndOp{}
 	gotVal := andOp.Eval(aladino.BuildTrueValue(), aladino.BuildTrueValue())
@@ -502,3 +618,547 @@ func TestEval_OnGreaterEqThanOp(t *testing.T) {
 
 	assert.Equal(t, wantVal, gotVal)
 }
+
+func TestEval_OnNegOp(t *testing.T) {
+	negOp := &aladino.NegOp{}
+	gotVal := negOp.Eval(aladino.BuildIntValue(3))
+
+	wantVal := aladino.BuildIntValue(-3)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnPlusOp(t *testing.T) {
+	plusOp := &aladino.PlusOp{}
+	gotVal := plusOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnPlusOp_WhenStrings(t *testing.T) {
+	plusOp := &aladino.PlusOp{}
+	gotVal := plusOp.Eval(aladino.BuildStringValue("foo"), aladino.BuildStringValue("bar"))
+
+	wantVal := aladino.BuildStringValue("foobar")
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMinusOp(t *testing.T) {
+	minusOp := &aladino.MinusOp{}
+	gotVal := minusOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(5))
+
+	wantVal := aladino.BuildIntValue(-2)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMultOp(t *testing.T) {
+	multOp := &aladino.MultOp{}
+	gotVal := multOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(5))
+
+	wantVal := aladino.BuildIntValue(15)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDivOp(t *testing.T) {
+	divOp := &aladino.DivOp{}
+	gotVal := divOp.Eval(aladino.BuildIntValue(7), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(3)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnModOp(t *testing.T) {
+	modOp := &aladino.ModOp{}
+	gotVal := modOp.Eval(aladino.BuildIntValue(7), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(1)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnBinaryOp_WhenArithmeticExpression(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	binaryOp, err := aladino.Parse("2 + 3 * 4 - 10 / 5 == 12")
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnBinaryOp_WhenStringConcatenation(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	binaryOp, err := aladino.Parse(`"foo" + $returnStr("bar")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	wantVal := aladino.BuildStringValue("foobar")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnBinaryOp_WhenDivisionByZero(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	for _, input := range []string{"1 / $zeroConst()", "1 % 0"} {
+		binaryOp, err := aladino.Parse(input)
+		if err != nil {
+			assert.FailNow(t, "parse failed", err)
+		}
+
+		gotVal, err := binaryOp.Eval(mockedEnv)
+
+		assert.Nil(t, gotVal)
+		assert.EqualError(t, err, "eval: division by zero")
+	}
+}
+
+func TestEval_OnFieldAccess(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+	mockedEnv.GetBuiltIns().Functions["lastCommit"] = &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildCommitType()),
+		Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildRecordValue(map[string]aladino.Value{
+				"sha":     aladino.BuildStringValue("1a2b3c"),
+				"author":  aladino.BuildStringValue("john"),
+				"message": aladino.BuildStringValue("Lorem Ipsum"),
+				"date":    aladino.BuildIntValue(0),
+			}), nil
+		},
+	}
+
+	fieldAccess, err := aladino.Parse(`$lastCommit().author`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotVal, err := fieldAccess.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildStringValue("john"), gotVal)
+}
+
+func TestEval_OnIndexAccess(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+		wantErr string
+	}{
+		"when map has key": {
+			expr:    `{"api/": 2, "web/": 1}["web/"]`,
+			wantVal: aladino.BuildIntValue(1),
+		},
+		"when array has index": {
+			expr:    `["a", "b"][1]`,
+			wantVal: aladino.BuildStringValue("b"),
+		},
+		"when map does not have key": {
+			expr:    `{"api/": 2}["web/"]`,
+			wantErr: `eval: key "web/" not found`,
+		},
+		"when index is out of range": {
+			expr:    `["a", "b"][2]`,
+			wantErr: "eval: index 2 out of range",
+		},
+		"when map has duplicate keys": {
+			expr:    `{"api/": 2, "api/": 1}["api/"]`,
+			wantErr: `eval: duplicate key "api/" in map`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexAccess, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := indexAccess.Eval(mockedEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotVal)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestEval_OnBinaryOp_WhenTimestampsAndDurations(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+	}{
+		"when timestamps are subtracted": {
+			expr:    `2022-04-05 - 2022-04-03T12:00:00Z`,
+			wantVal: aladino.BuildDurationValue(36 * 60 * 60),
+		},
+		"when duration is added to timestamp": {
+			expr:    `2022-04-05 + 2 days == 2022-04-07`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when duration is subtracted from timestamp": {
+			expr:    `2022-04-05 - 1 hour == 2022-04-04T23:00:00`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when durations are subtracted": {
+			expr:    `2 days - 1 hour`,
+			wantVal: aladino.BuildDurationValue(47 * 60 * 60),
+		},
+		"when durations are compared": {
+			expr:    `2 days >= 48 hours`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when timestamp is added to duration": {
+			expr:    `2 days + 2022-04-05 == 2022-04-07`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when duration is negated": {
+			expr:    `2022-04-05 + -(1 hour) == 2022-04-04T23:00:00`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when timestamp is compared to unix seconds": {
+			expr:    `2022-04-05 > 1649000000 && 2022-04-05 < 1650000000`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			binaryOp, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := binaryOp.Eval(mockedEnv)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestEval_OnBinaryOp_WhenMatchOperators(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+	}{
+		"when string matches": {
+			expr:    `"docs/README.md" =~ r"^docs/.*\.md$"`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when string does not match": {
+			expr:    `"src/main.go" =~ r"^docs/"`,
+			wantVal: aladino.BuildFalseValue(),
+		},
+		"when string does not match with negated operator": {
+			expr:    `"src/main.go" !~ r"^docs/"`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			binaryOp, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := binaryOp.Eval(mockedEnv)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestEval_OnRegexConst_WhenRegexIsInvalid(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	regex, err := aladino.Parse(`r"("`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotVal, err := regex.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: invalid regular expression \"(\": error parsing regexp: missing closing ): `(`")
+}
+
+func TestEval_OnBinaryOp_WhenBooleanOperatorsShortCircuit(t *testing.T) {
+	tests := map[string]struct {
+		expr       string
+		wantVal    aladino.Value
+		wantErr    string
+		wantCalled []string
+	}{
+		"when left operand decides and": {
+			expr:       `$cheapFalse() && $cheapFail()`,
+			wantVal:    aladino.BuildFalseValue(),
+			wantCalled: []string{"cheapFalse"},
+		},
+		"when left operand decides or": {
+			expr:       `$cheapTrue() || $cheapFail()`,
+			wantVal:    aladino.BuildTrueValue(),
+			wantCalled: []string{"cheapTrue"},
+		},
+		"when left operand does not decide": {
+			expr:       `$cheapTrue() && $cheapFail()`,
+			wantErr:    "cheapFail failed",
+			wantCalled: []string{"cheapTrue", "cheapFail"},
+		},
+		"when cheaper right operand decides": {
+			expr:       `$expensiveTrue() && $cheapFalse()`,
+			wantVal:    aladino.BuildFalseValue(),
+			wantCalled: []string{"cheapFalse"},
+		},
+		"when cheaper right operand decides and left operand fails": {
+			expr:       `$expensiveFail() && $cheapFalse()`,
+			wantErr:    "expensiveFail failed",
+			wantCalled: []string{"expensiveFail"},
+		},
+		"when cheaper right operand does not decide": {
+			expr:       `$expensiveTrue() || $cheapFalse()`,
+			wantVal:    aladino.BuildTrueValue(),
+			wantCalled: []string{"cheapFalse", "expensiveTrue"},
+		},
+		"when cheaper right operand fails and left operand decides": {
+			expr:       `$expensiveFalse() && $cheapFail()`,
+			wantVal:    aladino.BuildFalseValue(),
+			wantCalled: []string{"cheapFail", "expensiveFalse"},
+		},
+		"when cheaper right operand fails and left operand does not decide": {
+			expr:       `$expensiveTrue() && $cheapFail()`,
+			wantErr:    "cheapFail failed",
+			wantCalled: []string{"cheapFail", "expensiveTrue"},
+		},
+		"when operands have the same cost": {
+			expr:       `$expensiveTrue() || $expensiveFail()`,
+			wantVal:    aladino.BuildTrueValue(),
+			wantCalled: []string{"expensiveTrue"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotCalled []string
+			buildBuiltIn := func(name string, cost aladino.Cost, val aladino.Value) *aladino.BuiltInFunction {
+				return &aladino.BuiltInFunction{
+					Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
+					Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+						gotCalled = append(gotCalled, name)
+						if val == nil {
+							return nil, fmt.Errorf("%v failed", name)
+						}
+						return val, nil
+					},
+					Cost: cost,
+					// the built-ins that do not fail are total
+					Total: val != nil,
+				}
+			}
+
+			builtIns := aladino.MockBuiltIns()
+			builtIns.Functions["cheapTrue"] = buildBuiltIn("cheapTrue", aladino.COST_LOW, aladino.BuildTrueValue())
+			builtIns.Functions["cheapFalse"] = buildBuiltIn("cheapFalse", aladino.COST_LOW, aladino.BuildFalseValue())
+			builtIns.Functions["cheapFail"] = buildBuiltIn("cheapFail", aladino.COST_LOW, nil)
+			builtIns.Functions["expensiveTrue"] = buildBuiltIn("expensiveTrue", aladino.COST_HIGH, aladino.BuildTrueValue())
+			builtIns.Functions["expensiveFalse"] = buildBuiltIn("expensiveFalse", aladino.COST_HIGH, aladino.BuildFalseValue())
+			builtIns.Functions["expensiveFail"] = buildBuiltIn("expensiveFail", aladino.COST_HIGH, nil)
+
+			mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+			binaryOp, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := binaryOp.Eval(mockedEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotVal)
+				assert.EqualError(t, err, test.wantErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.wantVal, gotVal)
+			}
+			assert.Equal(t, test.wantCalled, gotCalled)
+		})
+	}
+}
+
+func TestEval_OnConditional(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+	}{
+		"when the condition holds": {
+			expr:    `if $zeroConst() == 0 then "zero" else "other"`,
+			wantVal: aladino.BuildStringValue("zero"),
+		},
+		"when the condition does not hold": {
+			expr:    `if $zeroConst() > 0 then "positive" else "other"`,
+			wantVal: aladino.BuildStringValue("other"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := expr.Eval(mockedEnv)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestEval_OnLet_EvaluatesValueOnce(t *testing.T) {
+	calls := 0
+	builtIns := &aladino.BuiltIns{
+		Functions: map[string]*aladino.BuiltInFunction{
+			"counter": {
+				Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildIntType()),
+				Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+					calls++
+					return aladino.BuildIntValue(calls), nil
+				},
+			},
+		},
+		Actions: map[string]*aladino.BuiltInAction{},
+	}
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	expr, err := aladino.Parse(`let $n = $counter() in $n + $n`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(2), gotVal)
+	assert.Equal(t, 1, calls)
+	assert.NotContains(t, mockedEnv.GetRegisterMap(), "n")
+}
+
+func TestEval_OnLet_WhenValueIsLambda(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr string
+	}{
+		"when the lambda is called": {
+			expr: `(let $f = ($x: Int => $x + 1) in $f(2)) == 3`,
+		},
+		"when the lambda hides a built-in": {
+			expr: `(let $zeroConst = ($x: Int => $x) in $zeroConst(5)) == 5`,
+		},
+		"when the lambda uses a variable bound by let": {
+			expr: `(let $y = 10 in let $f = ($x: Int => $x + $y) in $f(1)) == 11`,
+		},
+		"when the lambda is called after its variables are out of scope": {
+			expr: `(let $f = (let $y = 10 in ($x: Int => $x + $y)) in $f(1)) == 11`,
+		},
+		"when the lambda is called after its variables are redefined": {
+			expr: `(let $y = 10 in let $f = ($x: Int => $x + $y) in let $y = 20 in $f(1) + $y) == 31`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotVal, err := aladino.EvalExpr(mockedEnv, "patch", test.expr)
+
+			assert.Nil(t, err)
+			assert.True(t, gotVal)
+			assert.NotContains(t, mockedEnv.GetRegisterMap(), "f")
+			assert.NotContains(t, mockedEnv.GetRegisterMap(), "y")
+		})
+	}
+}
+
+func TestEval_OnTry(t *testing.T) {
+	builtIns := &aladino.BuiltIns{
+		Functions: map[string]*aladino.BuiltInFunction{
+			"fail": {
+				Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
+				Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+					return nil, fmt.Errorf("failed")
+				},
+			},
+			"succeed": {
+				Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
+				Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+					return aladino.BuildTrueValue(), nil
+				},
+			},
+		},
+		Actions: map[string]*aladino.BuiltInAction{},
+	}
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	tests := map[string]struct {
+		expr    string
+		wantVal aladino.Value
+	}{
+		"when the expression succeeds": {
+			expr:    `try($succeed(), false)`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when the expression fails": {
+			expr:    `try($fail(), false)`,
+			wantVal: aladino.BuildFalseValue(),
+		},
+		"when the expression fails inside an operation": {
+			expr:    `try($succeed() && $fail(), true)`,
+			wantVal: aladino.BuildTrueValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := aladino.Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotVal, err := expr.Eval(mockedEnv)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
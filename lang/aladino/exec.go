@@ -23,7 +23,7 @@ func TypeCheckExec(env Env, expr Expr) (ExecExpr, error) {
 		return expr.(*FunctionCall), nil
 	}
 
-	return nil, fmt.Errorf("typecheckexec: %v", expr.Kind())
+	return nil, typeError(expr.Pos(), "typecheckexec: %v", expr.Kind())
 }
 
 func (fc *FunctionCall) exec(env Env) error {
@@ -43,6 +43,15 @@ func (fc *FunctionCall) exec(env Env) error {
 		return fmt.Errorf("exec: %v not found. are you sure this is a built-in function?", fc.name.ident)
 	}
 
+	if fnType, ok := action.Type.(*FunctionType); ok {
+		arrangedArgs, err := fc.arrangeArgs(fnType, args)
+		if err != nil {
+			return err
+		}
+
+		args = arrangedArgs
+	}
+
 	if action.Disabled {
 		execLogf("action %v is disabled - skipping", fc.name.ident)
 		return nil
@@ -53,5 +62,9 @@ func (fc *FunctionCall) exec(env Env) error {
 		"builtin":        fc.name.ident,
 	})
 
+	// The action may have changed the pull request even if it failed.
+	defer env.GetBuiltInsCache().invalidate(action.Invalidates)
+	defer env.GetRequestsCache().invalidate(action.Invalidates)
+
 	return action.Code(env, args)
 }
@@ -21,7 +21,7 @@ func TestTypeCheckExec_WhenTypeInferenceFails(t *testing.T) {
 	execExpr, err := TypeCheckExec(mockedEnv, expr)
 
 	assert.Nil(t, execExpr)
-	assert.EqualError(t, err, "type inference failed: mismatch in arg types on emptyAction")
+	assert.EqualError(t, err, "type error at line 1, column 1: type inference failed: mismatch in arg types on emptyAction")
 }
 
 func TestTypeCheck(t *testing.T) {
@@ -34,7 +34,10 @@ func TestTypeCheck(t *testing.T) {
 
 	gotExecExpr, err := TypeCheckExec(mockedEnv, expr)
 
-	wantExecExpr := BuildFunctionCall(BuildVariable("emptyAction"), []Expr{})
+	wantExecExpr := withPos(BuildFunctionCall(
+		withPos(BuildVariable("emptyAction"), Position{1, 1}).(*Variable),
+		[]Expr{},
+	), Position{1, 1})
 
 	assert.Nil(t, err)
 	assert.Equal(t, wantExecExpr, gotExecExpr)
@@ -51,7 +54,7 @@ func TestTypeCheck_WhenExprIsNotFunctionCall(t *testing.T) {
 	gotExecExpr, err := TypeCheckExec(mockedEnv, expr)
 
 	assert.Nil(t, gotExecExpr)
-	assert.EqualError(t, err, "typecheckexec: StringConst")
+	assert.EqualError(t, err, "type error at line 1, column 1: typecheckexec: StringConst")
 }
 
 func TestExec_WhenFunctionArgsEvalFails(t *testing.T) {
@@ -16,9 +16,13 @@ import (
 
 type Expr interface {
 	Kind() string
+	Pos() Position
+	setPos(pos Position)
 	typeinfer(env TypeEnv) (Type, error)
 	Eval(Env) (Value, error)
 	equals(Expr) bool
+	// String writes the expression back to source, see FormatExpr.
+	String() string
 }
 
 const (
@@ -26,6 +30,8 @@ const (
 	INT_CONST           string = "IntConst"
 	STRING_CONST        string = "StringConst"
 	TIME_CONST          string = "TimeConst"
+	DURATION_CONST      string = "DurationConst"
+	REGEX_CONST         string = "RegexConst"
 	VARIABLE_CONST      string = "Variable"
 	UNARY_OP_CONST      string = "UnaryOp"
 	BINARY_OP_CONST     string = "BinaryOp"
@@ -33,6 +39,12 @@ const (
 	LAMBDA_CONST        string = "Lambda"
 	TYPED_EXPR          string = "TypedExpr"
 	ARRAY_CONST         string = "Array"
+	FIELD_ACCESS_CONST  string = "FieldAccess"
+	MAP_CONST           string = "Map"
+	INDEX_ACCESS_CONST  string = "IndexAccess"
+	CONDITIONAL_CONST   string = "Conditional"
+	LET_CONST           string = "Let"
+	TRY_CONST           string = "Try"
 	NOT_OP              string = "!"
 	EQ_OP               string = "=="
 	NEQ_OP              string = "!="
@@ -42,18 +54,53 @@ const (
 	LESS_EQ_THAN_OP     string = "<="
 	GREATER_THAN_OP     string = ">"
 	GREATER_EQ_THAN_OP  string = ">="
+	NEG_OP              string = "-"
+	PLUS_OP             string = "+"
+	MINUS_OP            string = "-"
+	MULT_OP             string = "*"
+	DIV_OP              string = "/"
+	MOD_OP              string = "%"
+	MATCH_OP            string = "=~"
+	NOT_MATCH_OP        string = "!~"
 )
 
+// node holds the data shared by every expression in the AST.
+type node struct {
+	pos Position
+}
+
+// Pos returns the position of the expression in the source.
+// Expressions that were not built by the parser have the zero position.
+func (n *node) Pos() Position {
+	return n.pos
+}
+
+func (n *node) setPos(pos Position) {
+	n.pos = pos
+}
+
+// withPos sets the position of expr and returns it.
+func withPos(expr Expr, pos Position) Expr {
+	if expr != nil {
+		expr.setPos(pos)
+	}
+
+	return expr
+}
+
 type UnaryOperator interface {
 	getOperator() string
 	Eval(exprValue Value) Value
 }
 
 type NotOp struct{}
+type NegOp struct{}
 
 func notOperator() *NotOp { return &NotOp{} }
+func negOperator() *NegOp { return &NegOp{} }
 
 func (op *NotOp) getOperator() string { return NOT_OP }
+func (op *NegOp) getOperator() string { return NEG_OP }
 
 type BinaryOperator interface {
 	getOperator() string
@@ -68,6 +115,13 @@ type LessThanOp struct{}
 type LessEqThanOp struct{}
 type GreaterThanOp struct{}
 type GreaterEqThanOp struct{}
+type PlusOp struct{}
+type MinusOp struct{}
+type MultOp struct{}
+type DivOp struct{}
+type ModOp struct{}
+type MatchOp struct{}
+type NotMatchOp struct{}
 
 func eqOperator() *EqOp                       { return &EqOp{} }
 func neqOperator() *NeqOp                     { return &NeqOp{} }
@@ -77,6 +131,13 @@ func lessThanOperator() *LessThanOp           { return &LessThanOp{} }
 func lessEqThanOperator() *LessEqThanOp       { return &LessEqThanOp{} }
 func greaterThanOperator() *GreaterThanOp     { return &GreaterThanOp{} }
 func greaterEqThanOperator() *GreaterEqThanOp { return &GreaterEqThanOp{} }
+func plusOperator() *PlusOp                   { return &PlusOp{} }
+func minusOperator() *MinusOp                 { return &MinusOp{} }
+func multOperator() *MultOp                   { return &MultOp{} }
+func divOperator() *DivOp                     { return &DivOp{} }
+func modOperator() *ModOp                     { return &ModOp{} }
+func matchOperator() *MatchOp                 { return &MatchOp{} }
+func notMatchOperator() *NotMatchOp           { return &NotMatchOp{} }
 
 func (op *EqOp) getOperator() string            { return EQ_OP }
 func (op *NeqOp) getOperator() string           { return NEQ_OP }
@@ -86,8 +147,16 @@ func (op *LessThanOp) getOperator() string      { return LESS_THAN_OP }
 func (op *LessEqThanOp) getOperator() string    { return LESS_EQ_THAN_OP }
 func (op *GreaterThanOp) getOperator() string   { return GREATER_THAN_OP }
 func (op *GreaterEqThanOp) getOperator() string { return GREATER_EQ_THAN_OP }
+func (op *PlusOp) getOperator() string          { return PLUS_OP }
+func (op *MinusOp) getOperator() string         { return MINUS_OP }
+func (op *MultOp) getOperator() string          { return MULT_OP }
+func (op *DivOp) getOperator() string           { return DIV_OP }
+func (op *ModOp) getOperator() string           { return MOD_OP }
+func (op *MatchOp) getOperator() string         { return MATCH_OP }
+func (op *NotMatchOp) getOperator() string      { return NOT_MATCH_OP }
 
 type BoolConst struct {
+	node
 	value bool
 }
 
@@ -108,11 +177,12 @@ func (thisBool *BoolConst) equals(other Expr) bool {
 }
 
 type StringConst struct {
+	node
 	value string
 }
 
 func BuildStringConst(val string) *StringConst {
-	return &StringConst{val}
+	return &StringConst{value: val}
 }
 
 func (c *StringConst) Kind() string {
@@ -128,11 +198,12 @@ func (thisString *StringConst) equals(other Expr) bool {
 }
 
 type IntConst struct {
+	node
 	value int
 }
 
 func BuildIntConst(val int) *IntConst {
-	return &IntConst{val}
+	return &IntConst{value: val}
 }
 
 func (i *IntConst) Kind() string {
@@ -147,10 +218,118 @@ func (thisInt *IntConst) equals(other Expr) bool {
 	return thisInt.value == other.(*IntConst).value
 }
 
-func BuildRelativeTimeConst(val string) *IntConst {
+// TimeConst is a timestamp, in seconds since the Unix epoch.
+type TimeConst struct {
+	node
+	value int
+	// ago is the literal of a relative timestamp, e.g. 3 months ago,
+	// so that it is written back relative to the time of the evaluation.
+	ago string
+}
+
+func (t *TimeConst) Kind() string {
+	return TIME_CONST
+}
+
+func (thisTime *TimeConst) equals(other Expr) bool {
+	if thisTime.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisTime.value == other.(*TimeConst).value
+}
+
+// RegexConst is a regular expression.
+// The expression is compiled once, when the type of the constant is inferred.
+type RegexConst struct {
+	node
+	pattern string
+	regex   *regexp.Regexp
+}
+
+func BuildRegexConst(pattern string) *RegexConst {
+	return &RegexConst{pattern: pattern}
+}
+
+func (r *RegexConst) Kind() string {
+	return REGEX_CONST
+}
+
+func (thisRegex *RegexConst) equals(other Expr) bool {
+	if thisRegex.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisRegex.pattern == other.(*RegexConst).pattern
+}
+
+// compile compiles the regular expression, if not compiled yet.
+func (r *RegexConst) compile() (*regexp.Regexp, error) {
+	if r.regex == nil {
+		regex, err := regexp.Compile(r.pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		r.regex = regex
+	}
+
+	return r.regex, nil
+}
+
+// DurationConst is a duration, in seconds.
+type DurationConst struct {
+	node
+	value int
+}
+
+func BuildDurationConst(val string) *DurationConst {
+	durationValueRegex := regexp.MustCompile(`^[0-9]+`)
+	durationValue, err := strconv.Atoi(durationValueRegex.FindString(val))
+	if err != nil {
+		log.Fatalf(report.Error(err.Error()))
+	}
+
+	durationUnitRegex := regexp.MustCompile(`week|day|hour|minute|second`)
+	durationUnit := durationUnitRegex.FindString(val)
+
+	var unit time.Duration
+	switch durationUnit {
+	case "week":
+		unit = time.Hour * 24 * 7
+	case "day":
+		unit = time.Hour * 24
+	case "hour":
+		unit = time.Hour
+	case "minute":
+		unit = time.Minute
+	case "second":
+		unit = time.Second
+	default:
+		log.Fatalf(report.Error("Unknown duration unit %v", durationUnit))
+	}
+
+	return &DurationConst{
+		value: int((unit * time.Duration(durationValue)).Seconds()),
+	}
+}
+
+func (d *DurationConst) Kind() string {
+	return DURATION_CONST
+}
+
+func (thisDuration *DurationConst) equals(other Expr) bool {
+	if thisDuration.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisDuration.value == other.(*DurationConst).value
+}
+
+func BuildRelativeTimeConst(val string) *TimeConst {
 	now := time.Now()
 
-	timeUnitRegex := regexp.MustCompile(`year|month|week|day|hour|minute`)
+	timeUnitRegex := regexp.MustCompile(`year|month|week|day|hour|minute|second`)
 	timeUnit := timeUnitRegex.FindString(val)
 
 	timeValueRegex := regexp.MustCompile(`^[0-9]+`)
@@ -159,94 +338,90 @@ func BuildRelativeTimeConst(val string) *IntConst {
 		log.Fatalf(report.Error(err.Error()))
 	}
 
+	ago := pluralize(timeValue, timeUnit) + " ago"
+
 	switch timeUnit {
 	case "year":
-		var a = now.AddDate(-timeValue, 0, 0)
-		a.UnixMilli()
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.AddDate(-timeValue, 0, 0).Unix()),
+			ago:   ago,
 		}
 	case "month":
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.AddDate(0, -timeValue, 0).Unix()),
+			ago:   ago,
 		}
 	case "day":
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.AddDate(0, 0, -timeValue).Unix()),
+			ago:   ago,
 		}
 	case "week":
 		week := time.Hour * 24 * 7
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.Add(-week * time.Duration(timeValue)).Unix()),
+			ago:   ago,
 		}
 	case "hour":
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.Add(-time.Hour * time.Duration(timeValue)).Unix()),
+			ago:   ago,
 		}
 	case "minute":
-		return &IntConst{
+		return &TimeConst{
 			value: int(now.Add(-time.Minute * time.Duration(timeValue)).Unix()),
+			ago:   ago,
+		}
+	case "second":
+		return &TimeConst{
+			value: int(now.Add(-time.Second * time.Duration(timeValue)).Unix()),
+			ago:   ago,
 		}
 	}
 
 	log.Fatalf(report.Error("Unknown time unit %v", timeUnit))
-	return &IntConst{}
+	return &TimeConst{}
 }
 
-func BuildTimeConst(val string) *IntConst {
-	dateValueRegex := regexp.MustCompile(`^(\d{4})-?(\d{2})-?(\d{2})`)
-	dateValue := dateValueRegex.FindSubmatch([]byte(val))
+// timestampLayouts are the formats of the timestamp literals.
+// Timestamps without a timezone are in UTC.
+var timestampLayouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"20060102T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"20060102T15:04:05",
+	"2006-01-02",
+	"20060102",
+}
 
-	year, err := strconv.Atoi(string(dateValue[1][:]))
-	if err != nil {
-		log.Fatalf(report.Error("Error converting year value %q", dateValue[1]))
+func parseTimestamp(val string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if timestamp, err := time.Parse(layout, val); err == nil {
+			return timestamp, nil
+		}
 	}
 
-	month, err := strconv.Atoi(string(dateValue[2][:]))
-	if err != nil {
-		log.Fatalf(report.Error("Error converting month value %q", dateValue[2]))
-	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", val)
+}
 
-	day, err := strconv.Atoi(string(dateValue[3][:]))
+func BuildTimeConst(val string) *TimeConst {
+	timestamp, err := parseTimestamp(val)
 	if err != nil {
-		log.Fatalf(report.Error("Error converting day value %q", dateValue[3]))
-	}
-
-	timeValueRegex := regexp.MustCompile(`T(\d{2}):(\d{2}):(\d{2})$`)
-	timeValue := timeValueRegex.FindSubmatch([]byte(val))
-
-	hour := 0
-	minute := 0
-	second := 0
-
-	if len(timeValue) > 0 {
-		hour, err = strconv.Atoi(string(timeValue[1][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting hour value %q", timeValue[1]))
-		}
-
-		minute, err = strconv.Atoi(string(timeValue[2][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting minute value %q", timeValue[2]))
-		}
-
-		second, err = strconv.Atoi(string(timeValue[3][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting second value %q", timeValue[3]))
-		}
+		log.Fatalf(report.Error(err.Error()))
 	}
 
-	return &IntConst{
-		value: int(time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC).Unix()),
+	return &TimeConst{
+		value: int(timestamp.Unix()),
 	}
 }
 
 type Variable struct {
+	node
 	ident string
 }
 
 func BuildVariable(ident string) *Variable {
-	return &Variable{ident}
+	return &Variable{ident: ident}
 }
 
 func (v *Variable) Kind() string {
@@ -262,15 +437,17 @@ func (thisVariable *Variable) equals(other Expr) bool {
 }
 
 type UnaryOp struct {
+	node
 	op   UnaryOperator
 	expr Expr
 }
 
 func BuildUnaryOp(op UnaryOperator, expr Expr) *UnaryOp {
-	return &UnaryOp{op, expr}
+	return &UnaryOp{op: op, expr: expr}
 }
 
 func BuildNotOp(expr Expr) *UnaryOp { return BuildUnaryOp(notOperator(), expr) }
+func BuildNegOp(expr Expr) *UnaryOp { return BuildUnaryOp(negOperator(), expr) }
 
 func (b *UnaryOp) Kind() string {
 	return UNARY_OP_CONST
@@ -289,13 +466,14 @@ func (thisUnaryOp *UnaryOp) equals(other Expr) bool {
 }
 
 type BinaryOp struct {
+	node
 	lhs Expr
 	op  BinaryOperator
 	rhs Expr
 }
 
 func BuildBinaryOp(lhs Expr, op BinaryOperator, rhs Expr) *BinaryOp {
-	return &BinaryOp{lhs, op, rhs}
+	return &BinaryOp{lhs: lhs, op: op, rhs: rhs}
 }
 
 func BuildAndOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, andOperator(), rhs) }
@@ -315,6 +493,16 @@ func BuildGreaterEqThanOp(lhs Expr, rhs Expr) *BinaryOp {
 	return BuildBinaryOp(lhs, greaterEqThanOperator(), rhs)
 }
 
+func BuildPlusOp(lhs Expr, rhs Expr) *BinaryOp  { return BuildBinaryOp(lhs, plusOperator(), rhs) }
+func BuildMinusOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, minusOperator(), rhs) }
+func BuildMultOp(lhs Expr, rhs Expr) *BinaryOp  { return BuildBinaryOp(lhs, multOperator(), rhs) }
+func BuildDivOp(lhs Expr, rhs Expr) *BinaryOp   { return BuildBinaryOp(lhs, divOperator(), rhs) }
+func BuildModOp(lhs Expr, rhs Expr) *BinaryOp   { return BuildBinaryOp(lhs, modOperator(), rhs) }
+func BuildMatchOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, matchOperator(), rhs) }
+func BuildNotMatchOp(lhs Expr, rhs Expr) *BinaryOp {
+	return BuildBinaryOp(lhs, notMatchOperator(), rhs)
+}
+
 func BuildCmpOp(lhs Expr, op string, rhs Expr) Expr {
 	switch op {
 	case LESS_THAN_OP:
@@ -349,12 +537,32 @@ func (thisBinOp *BinaryOp) equals(other Expr) bool {
 }
 
 type FunctionCall struct {
+	node
 	name      *Variable
 	arguments []Expr
+	// argNames are the names of the arguments given by name, or empty for the positional arguments.
+	// It is nil when all the arguments are positional.
+	argNames []string
 }
 
 func BuildFunctionCall(name *Variable, arguments []Expr) *FunctionCall {
-	return &FunctionCall{name, arguments}
+	return &FunctionCall{name: name, arguments: arguments}
+}
+
+// BuildFunctionCallWithArgNames builds a call whose arguments may be given by name,
+// e.g. $assignReviewer(reviewers: ["john"], total: 1).
+// The name of each positional argument is empty.
+func BuildFunctionCallWithArgNames(name *Variable, arguments []Expr, argNames []string) *FunctionCall {
+	return &FunctionCall{name: name, arguments: arguments, argNames: argNames}
+}
+
+// argName returns the name of the i-th argument, which is empty for positional arguments.
+func (fc *FunctionCall) argName(i int) string {
+	if fc.argNames == nil {
+		return ""
+	}
+
+	return fc.argNames[i]
 }
 
 func (fc *FunctionCall) Kind() string {
@@ -370,15 +578,26 @@ func (thisFnCall *FunctionCall) equals(other Expr) bool {
 	checkFunctionName := thisFnCall.name.equals(otherFunctionCall.name)
 	checkArgs := EqualList(thisFnCall.arguments, otherFunctionCall.arguments)
 
-	return checkFunctionName && checkArgs
+	if !checkFunctionName || !checkArgs {
+		return false
+	}
+
+	for i := range thisFnCall.arguments {
+		if thisFnCall.argName(i) != otherFunctionCall.argName(i) {
+			return false
+		}
+	}
+
+	return true
 }
 
 type Array struct {
+	node
 	elems []Expr
 }
 
 func BuildArray(elems []Expr) *Array {
-	return &Array{elems}
+	return &Array{elems: elems}
 }
 
 func (a *Array) Kind() string {
@@ -411,12 +630,13 @@ func EqualList(left []Expr, right []Expr) bool {
 }
 
 type TypedExpr struct {
+	node
 	expr   Expr
 	typeOf Type
 }
 
 func BuildTypedExpr(expr Expr, typeOf Type) *TypedExpr {
-	return &TypedExpr{expr, typeOf}
+	return &TypedExpr{expr: expr, typeOf: typeOf}
 }
 
 func (te *TypedExpr) Kind() string {
@@ -435,12 +655,29 @@ func (te *TypedExpr) equals(other Expr) bool {
 }
 
 type Lambda struct {
+	node
 	parameters []Expr
 	body       Expr
 }
 
 func BuildLambda(parameters []Expr, body Expr) *Lambda {
-	return &Lambda{parameters, body}
+	return &Lambda{parameters: parameters, body: body}
+}
+
+// paramIdents returns the names of the parameters of the lambda.
+// Parameters that are not typed variables are ignored.
+func (l *Lambda) paramIdents() []string {
+	idents := make([]string, 0, len(l.parameters))
+	for _, param := range l.parameters {
+		typedExpr, ok := param.(*TypedExpr)
+		if !ok || typedExpr.expr.Kind() != VARIABLE_CONST {
+			continue
+		}
+
+		idents = append(idents, typedExpr.expr.(*Variable).ident)
+	}
+
+	return idents
 }
 
 func (l *Lambda) Kind() string {
@@ -458,3 +695,177 @@ func (thisLambda *Lambda) equals(other Expr) bool {
 
 	return checkBody && checkParameters
 }
+
+// FieldAccess reads a field of a record, e.g. $commit.author.
+type FieldAccess struct {
+	node
+	expr  Expr
+	field string
+}
+
+func BuildFieldAccess(expr Expr, field string) *FieldAccess {
+	return &FieldAccess{expr: expr, field: field}
+}
+
+func (fa *FieldAccess) Kind() string {
+	return FIELD_ACCESS_CONST
+}
+
+func (fa *FieldAccess) equals(other Expr) bool {
+	if fa.Kind() != other.Kind() {
+		return false
+	}
+
+	otherFieldAccess := other.(*FieldAccess)
+
+	return fa.expr.equals(otherFieldAccess.expr) && fa.field == otherFieldAccess.field
+}
+
+// MapEntry is a key and its value in a map literal.
+type MapEntry struct {
+	key   Expr
+	value Expr
+}
+
+func BuildMapEntry(key, value Expr) MapEntry {
+	return MapEntry{key, value}
+}
+
+// Map is a map literal, e.g. {"api/": 2, "web/": 1}.
+type Map struct {
+	node
+	entries []MapEntry
+}
+
+func BuildMap(entries []MapEntry) *Map {
+	return &Map{entries: entries}
+}
+
+func (m *Map) Kind() string {
+	return MAP_CONST
+}
+
+func (m *Map) equals(other Expr) bool {
+	if m.Kind() != other.Kind() {
+		return false
+	}
+
+	otherMap := other.(*Map)
+	if len(m.entries) != len(otherMap.entries) {
+		return false
+	}
+
+	for i, entry := range m.entries {
+		otherEntry := otherMap.entries[i]
+		if !entry.key.equals(otherEntry.key) || !entry.value.equals(otherEntry.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IndexAccess reads an element of an array or the value of a key in a map, e.g. $owners["api/"].
+type IndexAccess struct {
+	node
+	expr  Expr
+	index Expr
+}
+
+func BuildIndexAccess(expr Expr, index Expr) *IndexAccess {
+	return &IndexAccess{expr: expr, index: index}
+}
+
+func (ia *IndexAccess) Kind() string {
+	return INDEX_ACCESS_CONST
+}
+
+func (ia *IndexAccess) equals(other Expr) bool {
+	if ia.Kind() != other.Kind() {
+		return false
+	}
+
+	otherIndexAccess := other.(*IndexAccess)
+
+	return ia.expr.equals(otherIndexAccess.expr) && ia.index.equals(otherIndexAccess.index)
+}
+
+// Conditional is an if expression, e.g. if $isDraft() then "draft" else "ready".
+type Conditional struct {
+	node
+	cond     Expr
+	thenExpr Expr
+	elseExpr Expr
+}
+
+func BuildConditional(cond Expr, thenExpr Expr, elseExpr Expr) *Conditional {
+	return &Conditional{cond: cond, thenExpr: thenExpr, elseExpr: elseExpr}
+}
+
+func (c *Conditional) Kind() string {
+	return CONDITIONAL_CONST
+}
+
+func (c *Conditional) equals(other Expr) bool {
+	if c.Kind() != other.Kind() {
+		return false
+	}
+
+	otherConditional := other.(*Conditional)
+
+	return c.cond.equals(otherConditional.cond) &&
+		c.thenExpr.equals(otherConditional.thenExpr) &&
+		c.elseExpr.equals(otherConditional.elseExpr)
+}
+
+// Let binds a variable to the value of an expression in the body, e.g. let $n = $size() in $n > 10 && $n < 100.
+// The expression is evaluated only once.
+type Let struct {
+	node
+	variable *Variable
+	value    Expr
+	body     Expr
+}
+
+func BuildLet(variable *Variable, value Expr, body Expr) *Let {
+	return &Let{variable: variable, value: value, body: body}
+}
+
+func (l *Let) Kind() string {
+	return LET_CONST
+}
+
+func (l *Let) equals(other Expr) bool {
+	if l.Kind() != other.Kind() {
+		return false
+	}
+
+	otherLet := other.(*Let)
+
+	return l.variable.equals(otherLet.variable) && l.value.equals(otherLet.value) && l.body.equals(otherLet.body)
+}
+
+// Try evaluates to the fallback when the evaluation of the expression fails, e.g. try($hasFileName("go.mod"), false).
+type Try struct {
+	node
+	expr     Expr
+	fallback Expr
+}
+
+func BuildTry(expr Expr, fallback Expr) *Try {
+	return &Try{expr: expr, fallback: fallback}
+}
+
+func (t *Try) Kind() string {
+	return TRY_CONST
+}
+
+func (t *Try) equals(other Expr) bool {
+	if t.Kind() != other.Kind() {
+		return false
+	}
+
+	otherTry := other.(*Try)
+
+	return t.expr.equals(otherTry.expr) && t.fallback.equals(otherTry.fallback)
+}
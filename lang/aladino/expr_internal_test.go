@@ -67,6 +67,24 @@ func TestGreaterEqThanOperator(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestArithmeticOperators(t *testing.T) {
+	assert.Equal(t, &PlusOp{}, plusOperator())
+	assert.Equal(t, &MinusOp{}, minusOperator())
+	assert.Equal(t, &MultOp{}, multOperator())
+	assert.Equal(t, &DivOp{}, divOperator())
+	assert.Equal(t, &ModOp{}, modOperator())
+	assert.Equal(t, &NegOp{}, negOperator())
+}
+
+func TestGetOperator_WhenArithmeticOp(t *testing.T) {
+	assert.Equal(t, PLUS_OP, plusOperator().getOperator())
+	assert.Equal(t, MINUS_OP, minusOperator().getOperator())
+	assert.Equal(t, MULT_OP, multOperator().getOperator())
+	assert.Equal(t, DIV_OP, divOperator().getOperator())
+	assert.Equal(t, MOD_OP, modOperator().getOperator())
+	assert.Equal(t, NEG_OP, negOperator().getOperator())
+}
+
 func TestGetOperator_WhenNotOp(t *testing.T) {
 	wantVal := NOT_OP
 	gotVal := notOperator().getOperator()
@@ -131,7 +149,7 @@ func TestGetOperator_WhenGreaterEqThanOp(t *testing.T) {
 }
 
 func TestBuildBoolConst(t *testing.T) {
-	wantVal := &BoolConst{true}
+	wantVal := &BoolConst{value: true}
 	gotVal := BuildBoolConst(true)
 
 	assert.Equal(t, wantVal, gotVal)
@@ -166,7 +184,7 @@ func TestBoolConstEquals_WhenEqual(t *testing.T) {
 }
 
 func TestBuildStringConst(t *testing.T) {
-	wantVal := &StringConst{"LoremIpsum"}
+	wantVal := &StringConst{value: "LoremIpsum"}
 	gotVal := BuildStringConst("LoremIpsum")
 
 	assert.Equal(t, wantVal, gotVal)
@@ -201,7 +219,7 @@ func TestStringConstEquals_WhenEqual(t *testing.T) {
 }
 
 func TestBuildIntConst(t *testing.T) {
-	wantVal := &IntConst{0}
+	wantVal := &IntConst{value: 0}
 	gotVal := BuildIntConst(0)
 
 	assert.Equal(t, wantVal, gotVal)
@@ -240,8 +258,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsYear(t *testing.T) {
 	val := "1 year ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.AddDate(-timeValue, 0, 0).Unix()),
+		ago:   "1 year ago",
 	}
 
 	gotVal := BuildRelativeTimeConst(val)
@@ -254,8 +273,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsMonth(t *testing.T) {
 	val := "1 month ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.AddDate(0, -timeValue, 0).Unix()),
+		ago:   "1 month ago",
 	}
 	gotVal := BuildRelativeTimeConst(val)
 
@@ -267,8 +287,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsDay(t *testing.T) {
 	val := "1 day ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.AddDate(0, 0, -timeValue).Unix()),
+		ago:   "1 day ago",
 	}
 
 	gotVal := BuildRelativeTimeConst(val)
@@ -281,8 +302,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsWeek(t *testing.T) {
 	val := "1 week ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.Add(-(time.Hour * 24 * 7) * time.Duration(timeValue)).Unix()),
+		ago:   "1 week ago",
 	}
 
 	gotVal := BuildRelativeTimeConst(val)
@@ -295,8 +317,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsHour(t *testing.T) {
 	val := "1 hour ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.Add(-time.Hour * time.Duration(timeValue)).Unix()),
+		ago:   "1 hour ago",
 	}
 
 	gotVal := BuildRelativeTimeConst(val)
@@ -309,8 +332,9 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsMinute(t *testing.T) {
 	val := "1 minute ago"
 	timeValue := 1
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(now.Add(-time.Minute * time.Duration(timeValue)).Unix()),
+		ago:   "1 minute ago",
 	}
 
 	gotVal := BuildRelativeTimeConst(val)
@@ -321,7 +345,7 @@ func TestBuildRelativeTimeConst_WhenTimeUnitIsMinute(t *testing.T) {
 func TestBuildTimeConst(t *testing.T) {
 	val := "2019-10-12T07:50:52"
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(time.Date(2019, time.Month(10), 12, 7, 50, 52, 0, time.UTC).Unix()),
 	}
 
@@ -330,8 +354,39 @@ func TestBuildTimeConst(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildTimeConst_WhenTimestampHasOffset(t *testing.T) {
+	val := "2019-10-12T07:50:52-03:00"
+
+	wantVal := &TimeConst{
+		value: int(time.Date(2019, time.Month(10), 12, 10, 50, 52, 0, time.UTC).Unix()),
+	}
+
+	gotVal := BuildTimeConst(val)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildDurationConst(t *testing.T) {
+	tests := map[string]struct {
+		val     string
+		wantVal *DurationConst
+	}{
+		"when unit is week":   {val: "2 weeks", wantVal: &DurationConst{value: 2 * 7 * 24 * 60 * 60}},
+		"when unit is day":    {val: "1 day", wantVal: &DurationConst{value: 24 * 60 * 60}},
+		"when unit is hour":   {val: "48 hours", wantVal: &DurationConst{value: 48 * 60 * 60}},
+		"when unit is minute": {val: "30 minutes", wantVal: &DurationConst{value: 30 * 60}},
+		"when unit is second": {val: "10 seconds", wantVal: &DurationConst{value: 10}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantVal, BuildDurationConst(test.val))
+		})
+	}
+}
+
 func TestBuildVariable(t *testing.T) {
-	wantVal := &Variable{"Test"}
+	wantVal := &Variable{ident: "Test"}
 	gotVal := BuildVariable("Test")
 
 	assert.Equal(t, wantVal, gotVal)
@@ -366,14 +421,14 @@ func TestVariableEquals_WhenEqual(t *testing.T) {
 }
 
 func TestBuildUnaryOp(t *testing.T) {
-	wantVal := &UnaryOp{&NotOp{}, &BoolConst{true}}
+	wantVal := &UnaryOp{op: &NotOp{}, expr: &BoolConst{value: true}}
 	gotVal := BuildUnaryOp(notOperator(), BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildNotOp(t *testing.T) {
-	wantVal := &UnaryOp{&NotOp{}, &BoolConst{true}}
+	wantVal := &UnaryOp{op: &NotOp{}, expr: &BoolConst{value: true}}
 	gotVal := BuildNotOp(BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
@@ -408,91 +463,91 @@ func TestUnaryOpEquals_WhenEqual(t *testing.T) {
 }
 
 func TestBuildBinaryOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &EqOp{}, &IntConst{1}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &EqOp{}, rhs: &IntConst{value: 1}}
 	gotVal := BuildBinaryOp(BuildIntConst(1), eqOperator(), BuildIntConst(1))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildAndOp(t *testing.T) {
-	wantVal := &BinaryOp{&BoolConst{true}, &AndOp{}, &BoolConst{true}}
+	wantVal := &BinaryOp{lhs: &BoolConst{value: true}, op: &AndOp{}, rhs: &BoolConst{value: true}}
 	gotVal := BuildAndOp(BuildBoolConst(true), BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildOrOp(t *testing.T) {
-	wantVal := &BinaryOp{&BoolConst{true}, &OrOp{}, &BoolConst{true}}
+	wantVal := &BinaryOp{lhs: &BoolConst{value: true}, op: &OrOp{}, rhs: &BoolConst{value: true}}
 	gotVal := BuildOrOp(BuildBoolConst(true), BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildEqOp(t *testing.T) {
-	wantVal := &BinaryOp{&BoolConst{true}, &EqOp{}, &BoolConst{true}}
+	wantVal := &BinaryOp{lhs: &BoolConst{value: true}, op: &EqOp{}, rhs: &BoolConst{value: true}}
 	gotVal := BuildEqOp(BuildBoolConst(true), BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildNeqOp(t *testing.T) {
-	wantVal := &BinaryOp{&BoolConst{true}, &NeqOp{}, &BoolConst{true}}
+	wantVal := &BinaryOp{lhs: &BoolConst{value: true}, op: &NeqOp{}, rhs: &BoolConst{value: true}}
 	gotVal := BuildNeqOp(BuildBoolConst(true), BuildBoolConst(true))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildLessThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &LessThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &LessThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildLessThanOp(BuildIntConst(1), BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildLessEqThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &LessEqThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &LessEqThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildLessEqThanOp(BuildIntConst(1), BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildGreaterThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &GreaterThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &GreaterThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildGreaterThanOp(BuildIntConst(1), BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildGreaterEqThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &GreaterEqThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &GreaterEqThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildGreaterEqThanOp(BuildIntConst(1), BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildCmpOp_WhenOpIsLessThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &LessThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &LessThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildCmpOp(BuildIntConst(1), LESS_THAN_OP, BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildCmpOp_WhenOpIsLessEqThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &LessEqThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &LessEqThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildCmpOp(BuildIntConst(1), LESS_EQ_THAN_OP, BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildCmpOp_WhenOpIsGreaterThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &GreaterThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &GreaterThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildCmpOp(BuildIntConst(1), GREATER_THAN_OP, BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildCmpOp_WhenOpIsGreaterEqThanOp(t *testing.T) {
-	wantVal := &BinaryOp{&IntConst{1}, &GreaterEqThanOp{}, &IntConst{2}}
+	wantVal := &BinaryOp{lhs: &IntConst{value: 1}, op: &GreaterEqThanOp{}, rhs: &IntConst{value: 2}}
 	gotVal := BuildCmpOp(BuildIntConst(1), GREATER_EQ_THAN_OP, BuildIntConst(2))
 
 	assert.Equal(t, wantVal, gotVal)
@@ -536,7 +591,7 @@ func TestBuildFunctionCall(t *testing.T) {
 	functionName := "foo"
 	functionArgs := []Expr{}
 
-	wantVal := &FunctionCall{&Variable{functionName}, functionArgs}
+	wantVal := &FunctionCall{name: &Variable{ident: functionName}, arguments: functionArgs}
 	gotVal := BuildFunctionCall(BuildVariable(functionName), functionArgs)
 
 	assert.Equal(t, wantVal, gotVal)
@@ -573,7 +628,7 @@ func TestFunctionCallEquals_WhenEqual(t *testing.T) {
 func TestBuildArray(t *testing.T) {
 	elems := []Expr{BuildIntConst(1)}
 
-	wantVal := &Array{elems}
+	wantVal := &Array{elems: elems}
 	gotVal := BuildArray(elems)
 
 	assert.Equal(t, wantVal, gotVal)
@@ -608,7 +663,7 @@ func TestArrayEquals_WhenEqual(t *testing.T) {
 }
 
 func TestBuildTypedExpr(t *testing.T) {
-	wantVal := &TypedExpr{&IntConst{1}, &IntType{}}
+	wantVal := &TypedExpr{expr: &IntConst{value: 1}, typeOf: &IntType{}}
 	gotVal := BuildTypedExpr(BuildIntConst(1), BuildIntType())
 
 	assert.Equal(t, wantVal, gotVal)
@@ -644,8 +699,8 @@ func TestTypedExprEquals_WhenEqual(t *testing.T) {
 
 func TestBuildLambda(t *testing.T) {
 	wantVal := &Lambda{
-		[]Expr{&TypedExpr{&Variable{"foo"}, &StringType{}}},
-		&BinaryOp{&IntConst{1}, &EqOp{}, &IntConst{1}},
+		parameters: []Expr{&TypedExpr{expr: &Variable{ident: "foo"}, typeOf: &StringType{}}},
+		body:       &BinaryOp{lhs: &IntConst{value: 1}, op: &EqOp{}, rhs: &IntConst{value: 1}},
 	}
 	gotVal := BuildLambda(
 		[]Expr{BuildTypedExpr(BuildVariable("foo"), BuildStringType())},
@@ -0,0 +1,179 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The precedences of the expressions, from the loosest to the tightest, as defined in the grammar.
+const (
+	precLowest = iota
+	precOr
+	precAnd
+	precComparison
+	precAdditive
+	precMultiplicative
+	precUnary
+	precAccess
+	precAtom
+)
+
+var binaryOpPrecedences = map[string]int{
+	OR_OP:              precOr,
+	AND_OP:             precAnd,
+	EQ_OP:              precComparison,
+	NEQ_OP:             precComparison,
+	LESS_THAN_OP:       precComparison,
+	LESS_EQ_THAN_OP:    precComparison,
+	GREATER_THAN_OP:    precComparison,
+	GREATER_EQ_THAN_OP: precComparison,
+	MATCH_OP:           precComparison,
+	NOT_MATCH_OP:       precComparison,
+	PLUS_OP:            precAdditive,
+	MINUS_OP:           precAdditive,
+	MULT_OP:            precMultiplicative,
+	DIV_OP:             precMultiplicative,
+	MOD_OP:             precMultiplicative,
+}
+
+// FormatExpr writes an expression in a single line, e.g. $size() > 10 && !$isDraft().
+// Parentheses are only added where the precedence of the operators requires them.
+// It is the canonical form of the expression: parsing it gives back the same expression.
+func FormatExpr(expr Expr) string {
+	return formatExpr(expr, precLowest)
+}
+
+// formatExpr writes expr between parentheses when it binds looser than minPrec.
+func formatExpr(expr Expr, minPrec int) string {
+	text, prec := formatExprWithPrec(expr)
+	if prec < minPrec {
+		return "(" + text + ")"
+	}
+
+	return text
+}
+
+func formatExprs(exprs []Expr) string {
+	texts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		texts[i] = FormatExpr(expr)
+	}
+	return strings.Join(texts, ", ")
+}
+
+func formatExprWithPrec(expr Expr) (string, int) {
+	switch e := expr.(type) {
+	case *BoolConst:
+		return fmt.Sprintf("%v", e.value), precAtom
+	case *IntConst:
+		return fmt.Sprintf("%v", e.value), precAtom
+	case *StringConst:
+		return fmt.Sprintf("%q", e.value), precAtom
+	case *TimeConst:
+		if e.ago != "" {
+			return e.ago, precAtom
+		}
+		return FormatValue(BuildTimeValue(e.value)), precAtom
+	case *DurationConst:
+		return formatDuration(e.value), precAtom
+	case *RegexConst:
+		if strings.Contains(e.pattern, `"`) {
+			return "r`" + e.pattern + "`", precAtom
+		}
+		return `r"` + e.pattern + `"`, precAtom
+	case *Variable:
+		return "$" + e.ident, precAtom
+	case *UnaryOp:
+		return e.op.getOperator() + formatExpr(e.expr, precUnary), precUnary
+	case *BinaryOp:
+		prec := binaryOpPrecedences[e.op.getOperator()]
+		// The operators are left associative.
+		return fmt.Sprintf("%v %v %v", formatExpr(e.lhs, prec), e.op.getOperator(), formatExpr(e.rhs, prec+1)), prec
+	case *FunctionCall:
+		args := make([]string, len(e.arguments))
+		for i, arg := range e.arguments {
+			args[i] = FormatExpr(arg)
+			if name := e.argName(i); name != "" {
+				args[i] = name + ": " + args[i]
+			}
+		}
+		return fmt.Sprintf("$%v(%v)", e.name.ident, strings.Join(args, ", ")), precAtom
+	case *Lambda:
+		return fmt.Sprintf("(%v => %v)", formatExprs(e.parameters), FormatExpr(e.body)), precAtom
+	case *TypedExpr:
+		return fmt.Sprintf("%v: %v", FormatExpr(e.expr), FormatType(e.typeOf)), precAtom
+	case *Array:
+		return "[" + formatExprs(e.elems) + "]", precAtom
+	case *Map:
+		entries := make([]string, len(e.entries))
+		for i, entry := range e.entries {
+			entries[i] = fmt.Sprintf("%v: %v", FormatExpr(entry.key), FormatExpr(entry.value))
+		}
+		return "{" + strings.Join(entries, ", ") + "}", precAtom
+	case *FieldAccess:
+		return formatExpr(e.expr, precAccess) + "." + e.field, precAccess
+	case *IndexAccess:
+		return fmt.Sprintf("%v[%v]", formatExpr(e.expr, precAccess), FormatExpr(e.index)), precAccess
+	case *Try:
+		return fmt.Sprintf("try(%v, %v)", FormatExpr(e.expr), FormatExpr(e.fallback)), precAtom
+	case *Conditional:
+		// Only the else branch extends as far as possible, so conditionals and let bindings elsewhere are parenthesized.
+		return fmt.Sprintf("if %v then %v else %v", formatExpr(e.cond, precOr), formatExpr(e.thenExpr, precOr), FormatExpr(e.elseExpr)), precLowest
+	case *Let:
+		return fmt.Sprintf("let $%v = %v in %v", e.variable.ident, formatExpr(e.value, precOr), FormatExpr(e.body)), precLowest
+	}
+
+	return "<" + expr.Kind() + ">", precAtom
+}
+
+func (b *BoolConst) String() string     { return FormatExpr(b) }
+func (i *IntConst) String() string      { return FormatExpr(i) }
+func (c *StringConst) String() string   { return FormatExpr(c) }
+func (t *TimeConst) String() string     { return FormatExpr(t) }
+func (d *DurationConst) String() string { return FormatExpr(d) }
+func (r *RegexConst) String() string    { return FormatExpr(r) }
+func (v *Variable) String() string      { return FormatExpr(v) }
+func (u *UnaryOp) String() string       { return FormatExpr(u) }
+func (b *BinaryOp) String() string      { return FormatExpr(b) }
+func (fc *FunctionCall) String() string { return FormatExpr(fc) }
+func (lambda *Lambda) String() string   { return FormatExpr(lambda) }
+func (te *TypedExpr) String() string    { return FormatExpr(te) }
+func (a *Array) String() string         { return FormatExpr(a) }
+func (m *Map) String() string           { return FormatExpr(m) }
+func (fa *FieldAccess) String() string  { return FormatExpr(fa) }
+func (ia *IndexAccess) String() string  { return FormatExpr(ia) }
+func (c *Conditional) String() string   { return FormatExpr(c) }
+func (l *Let) String() string           { return FormatExpr(l) }
+func (t *Try) String() string           { return FormatExpr(t) }
+
+// formatDuration writes a duration in seconds with the largest unit that divides it, e.g. 2 days.
+func formatDuration(seconds int) string {
+	units := []struct {
+		name    string
+		seconds int
+	}{
+		{"week", 7 * 24 * 60 * 60},
+		{"day", 24 * 60 * 60},
+		{"hour", 60 * 60},
+		{"minute", 60},
+	}
+
+	for _, unit := range units {
+		if seconds != 0 && seconds%unit.seconds == 0 {
+			return pluralize(seconds/unit.seconds, unit.name)
+		}
+	}
+
+	return pluralize(seconds, "second")
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %v", n, unit)
+	}
+	return fmt.Sprintf("%v %vs", n, unit)
+}
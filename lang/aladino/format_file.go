@@ -0,0 +1,264 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// formatFileIndent is the number of spaces used to indent the formatted reviewpad files.
+const formatFileIndent = 2
+
+// fileFormatter keeps the paths of the expressions that are left as written while formatting a file.
+type fileFormatter struct {
+	unformatted []string
+}
+
+// FormatFile rewrites a reviewpad file in its canonical form:
+// the expressions are written with FormatExpr, the labels are sorted by key
+// and the file is indented with two spaces. The comments of the YAML file are kept.
+// The expressions with comments are left as written, since FormatExpr would drop the comments,
+// and their paths (e.g. rules[0].spec) are returned so that they can be reported.
+// The file is not type checked, so it can be formatted without the built-ins.
+func FormatFile(data []byte) ([]byte, []string, error) {
+	var document yaml.Node
+	err := yaml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// An empty file has nothing to format.
+	if len(document.Content) == 0 {
+		return data, nil, nil
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("reviewpad file is not a mapping")
+	}
+
+	f := &fileFormatter{}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i].Value, root.Content[i+1]
+
+		switch key {
+		case "constants":
+			err = formatEach(value, key, func(item *yaml.Node, path string) error {
+				return f.formatFields(item, path, "value")
+			})
+		case "functions":
+			err = formatEach(value, key, func(item *yaml.Node, path string) error {
+				return f.formatFields(item, path, "body")
+			})
+		case "groups":
+			err = formatEach(value, key, func(item *yaml.Node, path string) error {
+				return f.formatFields(item, path, "spec", "where")
+			})
+		case "rules":
+			err = formatEach(value, key, func(item *yaml.Node, path string) error {
+				return f.formatFields(item, path, "spec")
+			})
+		case "labels":
+			sortMapping(value)
+		case "workflows":
+			err = formatEach(value, key, f.formatWorkflow)
+		case "pipelines":
+			err = formatEach(value, key, f.formatPipeline)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(formatFileIndent)
+
+	err = encoder.Encode(&document)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return separateSections(buf.Bytes()), f.unformatted, nil
+}
+
+// separateSections adds an empty line around each top-level section, such as rules or workflows,
+// since the empty lines of the original file are lost when it is decoded.
+// The comments right above a key stay attached to it.
+func separateSections(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	result := make([]string, 0, len(lines))
+	seenKey := false
+	afterSection := false
+
+	for _, line := range lines {
+		isTopLevelKey := line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "#")
+		if !isTopLevelKey {
+			result = append(result, line)
+			continue
+		}
+
+		// The value of a section is written in the lines below its key.
+		isSection := strings.HasSuffix(line, ":")
+		if seenKey && (isSection || afterSection) {
+			at := len(result)
+			for at > 0 && strings.HasPrefix(result[at-1], "#") {
+				at--
+			}
+
+			result = append(result[:at], append([]string{""}, result[at:]...)...)
+		}
+
+		seenKey = true
+		afterSection = isSection
+		result = append(result, line)
+	}
+
+	return []byte(strings.Join(result, "\n"))
+}
+
+func (f *fileFormatter) formatWorkflow(workflow *yaml.Node, path string) error {
+	// The rules of a workflow are either inline specs or references to rules with extra actions.
+	rules := mappingValue(workflow, "if")
+	err := formatEach(rules, path+".if", func(rule *yaml.Node, rulePath string) error {
+		if rule.Kind == yaml.ScalarNode {
+			return f.formatScalar(rule, rulePath)
+		}
+
+		err := formatEach(mappingValue(rule, "then"), rulePath+".then", f.formatScalar)
+		if err != nil {
+			return err
+		}
+
+		return formatEach(mappingValue(rule, "extra-actions"), rulePath+".extra-actions", f.formatScalar)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = formatEach(mappingValue(workflow, "then"), path+".then", f.formatScalar)
+	if err != nil {
+		return err
+	}
+
+	return formatEach(mappingValue(workflow, "else"), path+".else", f.formatScalar)
+}
+
+func (f *fileFormatter) formatPipeline(pipeline *yaml.Node, path string) error {
+	err := f.formatFields(pipeline, path, "trigger")
+	if err != nil {
+		return err
+	}
+
+	return formatEach(mappingValue(pipeline, "stages"), path+".stages", func(stage *yaml.Node, stagePath string) error {
+		err := f.formatFields(stage, stagePath, "until")
+		if err != nil {
+			return err
+		}
+
+		return formatEach(mappingValue(stage, "actions"), stagePath+".actions", f.formatScalar)
+	})
+}
+
+// formatEach calls format on every item of a sequence with the path of the item, e.g. rules[0].
+// Anything other than a sequence is left as is.
+func formatEach(sequence *yaml.Node, path string, format func(item *yaml.Node, path string) error) error {
+	if sequence == nil || sequence.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	for i, item := range sequence.Content {
+		err := format(item, fmt.Sprintf("%v[%v]", path, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatFields formats the expressions of the given fields of a mapping.
+func (f *fileFormatter) formatFields(mapping *yaml.Node, path string, fields ...string) error {
+	for _, field := range fields {
+		err := f.formatScalar(mappingValue(mapping, field), path+"."+field)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatScalar replaces an expression by its canonical form.
+// The style of the scalar is reset so that the expression is quoted only when needed.
+// An expression with comments is checked but left as written.
+func (f *fileFormatter) formatScalar(scalar *yaml.Node, path string) error {
+	if scalar == nil || scalar.Kind != yaml.ScalarNode || scalar.Value == "" {
+		return nil
+	}
+
+	expr, err := Parse(scalar.Value)
+	if err != nil {
+		return fmt.Errorf("%v: %w", path, err)
+	}
+
+	if hasComments(scalar.Value) {
+		f.unformatted = append(f.unformatted, path)
+		return nil
+	}
+
+	scalar.Value = FormatExpr(expr)
+	scalar.Style = 0
+
+	return nil
+}
+
+// mappingValue returns the value of key in a mapping, or nil if there is none.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// sortMapping sorts the entries of a mapping by key, keeping the comments of each entry.
+func sortMapping(mapping *yaml.Node) {
+	if mapping.Kind != yaml.MappingNode {
+		return
+	}
+
+	entries := make([][2]*yaml.Node, 0, len(mapping.Content)/2)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		entries = append(entries, [2]*yaml.Node{mapping.Content[i], mapping.Content[i+1]})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i][0].Value < entries[j][0].Value
+	})
+
+	for i, entry := range entries {
+		mapping.Content[2*i] = entry[0]
+		mapping.Content[2*i+1] = entry[1]
+	}
+}
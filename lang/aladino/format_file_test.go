@@ -0,0 +1,161 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatFile(t *testing.T) {
+	file := `# Reviewpad file of the project
+api-version:    reviewpad.com/v1alpha
+mode: verbose
+labels:
+    small:
+        color: "#aaa"   # green
+    # the largest pull requests
+    large:
+        description: large
+constants:
+    - name: limit
+      type: Int
+      value: 10*   3
+rules:
+    - name: is-small
+      kind: patch
+      spec: ($size() <= $limit)   &&  !$isDraft()
+    - name: is-stale
+      spec: |
+        $createdAt() < 2 weeks ago &&
+        $author() == "john"
+workflows:
+    - name: label
+      if:
+          - rule: is-small
+            then: ['$addLabel("small" )']
+            extra-actions:
+                - '$addLabel( "x" )'
+          - $hasFileName("a.go")
+      then:
+          # welcome the author
+          - $comment(` + "`hi \"there\"`" + `)
+      else:
+          - $addLabel(  "other")
+pipelines:
+    - name: stages
+      trigger: $size()>1
+      stages:
+          - actions: ['$addLabel("a")']
+            until: $rule("is-small")
+`
+
+	wantFile := `# Reviewpad file of the project
+api-version: reviewpad.com/v1alpha
+mode: verbose
+
+labels:
+  # the largest pull requests
+  large:
+    description: large
+  small:
+    color: "#aaa" # green
+
+constants:
+  - name: limit
+    type: Int
+    value: 10 * 3
+
+rules:
+  - name: is-small
+    kind: patch
+    spec: $size() <= $limit && !$isDraft()
+  - name: is-stale
+    spec: $createdAt() < 2 weeks ago && $author() == "john"
+
+workflows:
+  - name: label
+    if:
+      - rule: is-small
+        then: [$addLabel("small")]
+        extra-actions:
+          - $addLabel("x")
+      - $hasFileName("a.go")
+    then:
+      # welcome the author
+      - $comment("hi \"there\"")
+    else:
+      - $addLabel("other")
+
+pipelines:
+  - name: stages
+    trigger: $size() > 1
+    stages:
+      - actions: [$addLabel("a")]
+        until: $rule("is-small")
+`
+
+	gotFile, gotUnformatted, err := aladino.FormatFile([]byte(file))
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantFile, string(gotFile))
+	assert.Empty(t, gotUnformatted)
+
+	formattedTwice, _, err := aladino.FormatFile(gotFile)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantFile, string(formattedTwice))
+}
+
+func TestFormatFile_WhenExpressionHasComments(t *testing.T) {
+	file := `rules:
+  - name: is-stale
+    spec: |
+      # older than two weeks
+      $createdAt() < 2 weeks ago &&
+      $author() == "john" // the bot
+  - name: is-small
+    spec: $size()   <  10
+  - name: has-hash
+    spec: $title() == "# not a comment"
+`
+
+	wantFile := `rules:
+  - name: is-stale
+    spec: |
+      # older than two weeks
+      $createdAt() < 2 weeks ago &&
+      $author() == "john" // the bot
+  - name: is-small
+    spec: $size() < 10
+  - name: has-hash
+    spec: $title() == "# not a comment"
+`
+
+	gotFile, gotUnformatted, err := aladino.FormatFile([]byte(file))
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantFile, string(gotFile))
+	assert.Equal(t, []string{"rules[0].spec"}, gotUnformatted)
+}
+
+func TestFormatFile_WhenExpressionIsInvalid(t *testing.T) {
+	file := `rules:
+  - name: is-small
+    spec: $size() <
+`
+
+	_, _, err := aladino.FormatFile([]byte(file))
+
+	assert.EqualError(t, err, "rules[0].spec: parse error at line 1, column 10: unexpected end of input\n    $size() <\n             ^")
+}
+
+func TestFormatFile_WhenFileIsNotAMapping(t *testing.T) {
+	_, _, err := aladino.FormatFile([]byte("- rules"))
+
+	assert.EqualError(t, err, "reviewpad file is not a mapping")
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatExpr(t *testing.T) {
+	tests := map[string]struct {
+		inputSource string
+		wantText    string
+	}{
+		"when expression is a call with named arguments": {
+			inputSource: `$hasFileName(  name:"go.mod" )`,
+			wantText:    `$hasFileName(name: "go.mod")`,
+		},
+		"when parentheses are redundant": {
+			inputSource: `($size() > 10) && (!$isDraft())`,
+			wantText:    `$size() > 10 && !$isDraft()`,
+		},
+		"when parentheses change the precedence": {
+			inputSource: `($a || $b) && $c`,
+			wantText:    `($a || $b) && $c`,
+		},
+		"when operators are left associative": {
+			inputSource: `1 - (2 - 3)`,
+			wantText:    `1 - (2 - 3)`,
+		},
+		"when expression is a lambda": {
+			inputSource: `$filter($reviewers(), ($r: String => $r != "john"))`,
+			wantText:    `$filter($reviewers(), ($r: String => $r != "john"))`,
+		},
+		"when expression is an access": {
+			inputSource: `$commits()[0].message`,
+			wantText:    `$commits()[0].message`,
+		},
+		"when expression is a conditional": {
+			inputSource: `if $isDraft() then 1 week else 2 days`,
+			wantText:    `if $isDraft() then 1 week else 2 days`,
+		},
+		"when expression is a let binding": {
+			inputSource: `let $n = $size() in $n > 10 || try($fails(), false)`,
+			wantText:    `let $n = $size() in $n > 10 || try($fails(), false)`,
+		},
+		"when expression is a nested let binding": {
+			inputSource: `let $a = (let $b = 1 in $b) in $a`,
+			wantText:    `let $a = (let $b = 1 in $b) in $a`,
+		},
+		"when expression is a conditional operand": {
+			inputSource: `(if $isDraft() then 1 else 2) + 3`,
+			wantText:    `(if $isDraft() then 1 else 2) + 3`,
+		},
+		"when expression is a relative timestamp": {
+			inputSource: `$createdAt() < 3 months ago`,
+			wantText:    `$createdAt() < 3 months ago`,
+		},
+		"when expression is a timestamp": {
+			inputSource: `$createdAt() < 20220405`,
+			wantText:    `$createdAt() < 2022-04-05T00:00:00Z`,
+		},
+		"when expression is a map of regular expressions": {
+			inputSource: `{"docs": r"\.md$"}`,
+			wantText:    `{"docs": r"\.md$"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := aladino.Parse(test.inputSource)
+			if err != nil {
+				assert.FailNow(t, "Parse: %v", err)
+			}
+
+			assert.Equal(t, test.wantText, aladino.FormatExpr(expr))
+		})
+	}
+}
+
+func TestFormatExpr_RoundTripsThroughParse(t *testing.T) {
+	sources := []string{
+		`!($a && $b) || -(1 + 2) * 3 > 0`,
+		`if (if $a then $b else $c) then 1 week else 2 days`,
+		`$x =~ r"a\.b" && "a\tb" !~ r` + "`\"`",
+		`{"a": [1, 2], "b": []}["a"][0]`,
+		`try($commits()[0].message, "") == "fix"`,
+		`$comment(` + "`raw \"text\"`" + `)`,
+	}
+
+	for _, source := range sources {
+		t.Run(source, func(t *testing.T) {
+			expr, err := aladino.Parse(source)
+			if err != nil {
+				assert.FailNow(t, "Parse: %v", err)
+			}
+
+			formatted := expr.String()
+
+			reparsedExpr, err := aladino.Parse(formatted)
+			if err != nil {
+				assert.FailNow(t, "Parse of formatted expression: %v", err)
+			}
+
+			assert.Equal(t, formatted, aladino.FormatExpr(reparsedExpr))
+		})
+	}
+}
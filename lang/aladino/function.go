@@ -0,0 +1,137 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+)
+
+// buildUserFunction compiles a function declared in the reviewpad file
+// into a built-in function that can be called like any other built-in.
+// The function body can only call the built-ins already known by the environment,
+// so a function cannot call itself nor the functions declared after it.
+func buildUserFunction(env Env, name string, parameters []engine.PadFunctionParameter, returnType, body string) (*BuiltInFunction, error) {
+	builtIns := env.GetBuiltIns()
+
+	if isBuiltIn(builtIns, name) {
+		return nil, fmt.Errorf("function %v is already defined", name)
+	}
+
+	params := make([]Expr, len(parameters))
+	paramNames := make([]string, len(parameters))
+	for i, parameter := range parameters {
+		if isBuiltIn(builtIns, parameter.Name) {
+			return nil, fmt.Errorf("parameter %v of function %v has the name of a built-in", parameter.Name, name)
+		}
+
+		paramType, err := parseType(parameter.Type)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %v of function %v: %w", parameter.Name, name, err)
+		}
+
+		params[i] = BuildTypedExpr(BuildVariable(parameter.Name), paramType)
+		paramNames[i] = parameter.Name
+	}
+
+	bodyAST, err := Parse(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if call, ok := findCall(bodyAST, name); ok {
+		return nil, withSource(typeError(call.Pos(), "function %v cannot call itself", name), body)
+	}
+
+	lambda := BuildLambda(params, bodyAST)
+
+	lambdaType, err := TypeInference(env, lambda)
+	if err != nil {
+		return nil, withSource(err, body)
+	}
+
+	fnType := lambdaType.(*FunctionType).WithParamNames(paramNames...)
+
+	if returnType != "" {
+		declaredReturnType, err := parseType(returnType)
+		if err != nil {
+			return nil, fmt.Errorf("return type of function %v: %w", name, err)
+		}
+
+		if !declaredReturnType.equals(fnType.returnType) {
+			return nil, withSource(typeError(bodyAST.Pos(), "body of function %v does not have type %v", name, returnType), body)
+		}
+	}
+
+	return &BuiltInFunction{
+		Type: fnType,
+		Code: func(e Env, args []Value) (Value, error) {
+			// the functions of the reviewpad file are not nested in any scope, so they capture nothing
+			return lambda.apply(e, nil, args)
+		},
+		Cost: estimateCost(env.GetBuiltIns(), bodyAST),
+	}, nil
+}
+
+func isBuiltIn(builtIns *BuiltIns, name string) bool {
+	if _, ok := builtIns.Functions[name]; ok {
+		return true
+	}
+
+	_, ok := builtIns.Actions[name]
+	return ok
+}
+
+// findCall returns the first reference to the function name in expr.
+func findCall(expr Expr, name string) (Expr, bool) {
+	switch e := expr.(type) {
+	case *Variable:
+		if e.ident == name {
+			return e, true
+		}
+	case *FunctionCall:
+		if e.name.ident == name {
+			return e, true
+		}
+		return findCallInList(e.arguments, name)
+	case *UnaryOp:
+		return findCall(e.expr, name)
+	case *BinaryOp:
+		return findCallInList([]Expr{e.lhs, e.rhs}, name)
+	case *Array:
+		return findCallInList(e.elems, name)
+	case *Lambda:
+		return findCall(e.body, name)
+	case *FieldAccess:
+		return findCall(e.expr, name)
+	case *Map:
+		exprs := make([]Expr, 0, 2*len(e.entries))
+		for _, entry := range e.entries {
+			exprs = append(exprs, entry.key, entry.value)
+		}
+		return findCallInList(exprs, name)
+	case *IndexAccess:
+		return findCallInList([]Expr{e.expr, e.index}, name)
+	case *Conditional:
+		return findCallInList([]Expr{e.cond, e.thenExpr, e.elseExpr}, name)
+	case *Let:
+		return findCallInList([]Expr{e.value, e.body}, name)
+	case *Try:
+		return findCallInList([]Expr{e.expr, e.fallback}, name)
+	}
+
+	return nil, false
+}
+
+func findCallInList(exprs []Expr, name string) (Expr, bool) {
+	for _, expr := range exprs {
+		if call, ok := findCall(expr, name); ok {
+			return call, true
+		}
+	}
+
+	return nil, false
+}
@@ -41,34 +41,89 @@ func buildGroupAST(typeOf engine.GroupType, expr, paramExpr, whereExpr string) (
 	}
 }
 
-func evalGroup(env Env, expr Expr) (Value, error) {
+func typeCheckGroup(env Env, expr Expr) error {
 	exprType, err := TypeInference(env, expr)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if exprType.Kind() != ARRAY_TYPE && exprType.Kind() != ARRAY_OF_TYPE {
-		return nil, fmt.Errorf("expression is not a valid group")
+		return typeError(expr.Pos(), "expression is not a valid group")
+	}
+
+	return nil
+}
+
+func evalGroup(env Env, expr Expr) (Value, error) {
+	err := typeCheckGroup(env, expr)
+	if err != nil {
+		return nil, err
 	}
 
 	return Eval(env, expr)
 }
 
+// CompileGroup parses and type checks a group so that it is evaluated by ProcessGroup without being compiled again.
+func (i *Interpreter) CompileGroup(groupName string, kind engine.GroupKind, typeOf engine.GroupType, expr, paramExpr, whereExpr string) error {
+	exprAST, err := compileGroup(i.Env, typeOf, expr, paramExpr, whereExpr)
+	if err != nil {
+		return fmt.Errorf("CompileGroup: %w", err)
+	}
+
+	i.Env.GetCompiledExprs().groups[groupName] = exprAST
+	return nil
+}
+
 func (i *Interpreter) ProcessGroup(groupName string, kind engine.GroupKind, typeOf engine.GroupType, expr, paramExpr, whereExpr string) error {
+	if exprAST, ok := i.Env.GetCompiledExprs().groups[groupName]; ok {
+		value, err := Eval(i.Env, exprAST)
+		if err != nil {
+			return fmt.Errorf("ProcessGroup:evalGroup %w", err)
+		}
+
+		i.Env.GetRegisterMap()[groupName] = value
+		return nil
+	}
+
 	exprAST, err := buildGroupAST(typeOf, expr, paramExpr, whereExpr)
 	if err != nil {
-		return fmt.Errorf("ProcessGroup:buildGroupAST: %v", err)
+		return fmt.Errorf("ProcessGroup:buildGroupAST: %w", err)
 	}
 
 	value, err := evalGroup(i.Env, exprAST)
 	if err != nil {
-		return fmt.Errorf("ProcessGroup:evalGroup %v", err)
+		source := expr
+		if typeOf == engine.GroupTypeFilter {
+			source = whereExpr
+		}
+
+		return fmt.Errorf("ProcessGroup:evalGroup %w", withSource(err, source))
 	}
 
 	i.Env.GetRegisterMap()[groupName] = value
 	return nil
 }
 
+func (i *Interpreter) ProcessFunction(name string, parameters []engine.PadFunctionParameter, returnType, body string) error {
+	function, err := buildUserFunction(i.Env, name, parameters, returnType, body)
+	if err != nil {
+		return fmt.Errorf("ProcessFunction: %w", err)
+	}
+
+	i.Env.GetBuiltIns().Functions[name] = function
+	return nil
+}
+
+func (i *Interpreter) ProcessConstant(name, typeOf, value string) error {
+	constant, err := buildConstant(i.Env, name, typeOf, value)
+	if err != nil {
+		return fmt.Errorf("ProcessConstant: %w", err)
+	}
+
+	i.Env.GetBuiltIns().Functions[name] = constant
+	return nil
+}
+
 func BuildInternalLabelID(id string) string {
 	return fmt.Sprintf("@label:%v", id)
 }
@@ -92,33 +147,50 @@ func (i *Interpreter) ProcessRule(name, spec string) error {
 }
 
 func EvalExpr(env Env, kind, expr string) (bool, error) {
-	exprAST, err := Parse(expr)
-	if err != nil {
-		return false, err
-	}
-
-	exprType, err := TypeInference(env, exprAST)
+	exprAST, err := compileCondition(env, expr)
 	if err != nil {
 		return false, err
 	}
 
-	if exprType.Kind() != BOOL_TYPE {
-		return false, fmt.Errorf("expression %v is not a condition", expr)
-	}
-
 	return EvalCondition(env, exprAST)
 }
 
+func (i *Interpreter) CompileExpr(kind, expr string) error {
+	_, err := compileCondition(i.Env, expr)
+	return err
+}
+
 func (i *Interpreter) EvalExpr(kind, expr string) (bool, error) {
 	return EvalExpr(i.Env, kind, expr)
 }
 
+func (i *Interpreter) ExplainExpr(kind, expr string) (bool, string, error) {
+	result, trace, err := Explain(i.Env, expr)
+	if trace == nil {
+		return result, "", err
+	}
+
+	return result, trace.String(), err
+}
+
 func (i *Interpreter) ExecProgram(program *engine.Program) (engine.ExitStatus, error) {
 	execLog("executing program")
 
 	for _, statement := range program.GetProgramStatements() {
 		err := i.ExecStatement(statement)
 		if err != nil {
+			path := statement.GetStatementPath()
+			if path != "" {
+				err = fmt.Errorf("%v: %w", path, err)
+			}
+
+			// the failing action is skipped and the remaining ones are still executed
+			if statement.IgnoresErrors() {
+				execLogf("\tignoring error: %v", err)
+				i.ReportWarning(fmt.Sprintf("action %v failed and was skipped: %v", statement.GetStatementCode(), err))
+				continue
+			}
+
 			return engine.ExitStatusFailure, err
 		}
 
@@ -134,20 +206,25 @@ func (i *Interpreter) ExecProgram(program *engine.Program) (engine.ExitStatus, e
 	return engine.ExitStatusSuccess, nil
 }
 
+func (i *Interpreter) CompileStatement(statement string) (engine.CompiledStatement, error) {
+	return compileStatement(i.Env, statement)
+}
+
 func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
 	statRaw := statement.GetStatementCode()
-	statAST, err := Parse(statRaw)
-	if err != nil {
-		return err
-	}
 
-	execStatAST, err := TypeCheckExec(i.Env, statAST)
-	if err != nil {
-		return err
+	// statements that were not compiled with the reviewpad file are compiled now
+	execStatAST, ok := statement.GetCompiledStatement().(ExecExpr)
+	if !ok {
+		var err error
+		execStatAST, err = compileStatement(i.Env, statRaw)
+		if err != nil {
+			return err
+		}
 	}
 
 	if !i.Env.GetDryRun() {
-		err = execStatAST.exec(i.Env)
+		err := execStatAST.exec(i.Env)
 		if err != nil {
 			return err
 		}
@@ -159,6 +236,18 @@ func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
 	return nil
 }
 
+// ReportExplanation adds to the report why the rules of a workflow were activated or not.
+func (i *Interpreter) ReportExplanation(workflow, explanation string) {
+	report := i.Env.GetReport()
+	report.Explanations = append(report.Explanations, Explanation{Workflow: workflow, Trace: explanation})
+}
+
+// ReportWarning adds a warning to the report of the pull request.
+func (i *Interpreter) ReportWarning(message string) {
+	reportedMessages := i.Env.GetBuiltInsReportedMessages()
+	reportedMessages[SEVERITY_WARNING] = append(reportedMessages[SEVERITY_WARNING], message)
+}
+
 func (i *Interpreter) Report(mode string, safeMode bool) error {
 	execLog("generating report")
 
@@ -178,7 +267,7 @@ func (i *Interpreter) Report(mode string, safeMode bool) error {
 
 	reportComments := env.GetBuiltInsReportedMessages()
 
-	if mode == engine.SILENT_MODE && len(reportComments) == 0 && !safeMode {
+	if mode == engine.SILENT_MODE && len(reportComments) == 0 && len(env.GetReport().Explanations) == 0 && !safeMode {
 		if comment != nil {
 			return DeleteReportComment(env, *comment.ID)
 		}
@@ -30,7 +30,7 @@ func TestBuildGroupAST_WhenGroupTypeFilterIsSetAndParseFails(t *testing.T) {
 	)
 
 	assert.Nil(t, gotExpr)
-	assert.EqualError(t, err, "parse error: failed to build AST on input $hasFileExtensions(")
+	assert.EqualError(t, err, "parse error at line 1, column 20: unexpected end of input\n    $hasFileExtensions(\n                       ^")
 }
 
 func TestBuildGroupAST_WhenGroupTypeFilterIsSet(t *testing.T) {
@@ -52,12 +52,14 @@ func TestBuildGroupAST_WhenGroupTypeFilterIsSet(t *testing.T) {
 			),
 			BuildLambda(
 				[]Expr{BuildTypedExpr(BuildVariable("dev"), BuildStringType())},
-				BuildFunctionCall(
-					BuildVariable("hasFileExtensions"),
+				withPos(BuildFunctionCall(
+					withPos(BuildVariable("hasFileExtensions"), Position{1, 1}).(*Variable),
 					[]Expr{
-						BuildArray([]Expr{BuildStringConst(".ts")}),
+						withPos(BuildArray([]Expr{
+							withPos(BuildStringConst(".ts"), Position{1, 21}),
+						}), Position{1, 20}),
 					},
-				),
+				), Position{1, 1}),
 			),
 		},
 	)
@@ -76,7 +78,9 @@ func TestBuildGroupAST_WhenGroupTypeFilterIsNotSet(t *testing.T) {
 		"",
 	)
 
-	wantExpr := BuildArray([]Expr{BuildStringConst(devName)})
+	wantExpr := withPos(BuildArray([]Expr{
+		withPos(BuildStringConst(devName), Position{1, 2}),
+	}), Position{1, 1})
 
 	assert.Nil(t, err)
 	assert.Equal(t, wantExpr, gotExpr)
@@ -92,7 +96,7 @@ func TestEvalGroup_WhenTypeInferenceFails(t *testing.T) {
 
 	_, err = evalGroup(mockedEnv, expr)
 
-	assert.EqualError(t, err, "type inference failed")
+	assert.EqualError(t, err, "type error at line 1, column 3: type inference failed")
 }
 
 func TestEvalGroup_WhenExpressionIsNotValidGroup(t *testing.T) {
@@ -105,7 +109,7 @@ func TestEvalGroup_WhenExpressionIsNotValidGroup(t *testing.T) {
 
 	_, err = evalGroup(mockedEnv, expr)
 
-	assert.EqualError(t, err, "expression is not a valid group")
+	assert.EqualError(t, err, "type error at line 1, column 1: expression is not a valid group")
 }
 
 func TestEvalGroup(t *testing.T) {
@@ -156,7 +160,7 @@ func TestProcessGroup_WhenBuildGroupASTFails(t *testing.T) {
 		"",
 	)
 
-	assert.EqualError(t, err, fmt.Sprintf("ProcessGroup:buildGroupAST: parse error: failed to build AST on input %v", errExpr))
+	assert.EqualError(t, err, fmt.Sprintf("ProcessGroup:buildGroupAST: parse error at line 1, column 8: unexpected end of input\n    %v\n           ^", errExpr))
 }
 
 func TestProcessGroup_WhenEvalGroupFails(t *testing.T) {
@@ -178,7 +182,39 @@ func TestProcessGroup_WhenEvalGroupFails(t *testing.T) {
 		"",
 	)
 
-	assert.EqualError(t, err, "ProcessGroup:evalGroup expression is not a valid group")
+	assert.EqualError(t, err, "ProcessGroup:evalGroup type error at line 1, column 1: expression is not a valid group\n    true\n    ^")
+}
+
+func TestCompileGroup(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, &BuiltIns{}, nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.CompileGroup("seniors", engine.GroupKindDeveloper, engine.GroupTypeStatic, `["jane"]`, "", "")
+	assert.Nil(t, err)
+
+	// the group is compiled without being evaluated
+	assert.NotContains(t, mockedEnv.GetRegisterMap(), "seniors")
+
+	err = mockedInterpreter.ProcessGroup("seniors", engine.GroupKindDeveloper, engine.GroupTypeStatic, `["jane"]`, "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildArrayValue([]Value{BuildStringValue("jane")}), mockedEnv.GetRegisterMap()["seniors"])
+}
+
+func TestCompileGroup_WhenGroupIsNotValid(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, &BuiltIns{}, nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.CompileGroup("seniors", engine.GroupKindDeveloper, engine.GroupTypeStatic, "true", "", "")
+
+	assert.EqualError(t, err, "CompileGroup: type error at line 1, column 1: expression is not a valid group\n    true\n    ^")
+	assert.Empty(t, mockedEnv.GetCompiledExprs().groups)
 }
 
 func TestProcessGroup_WhenGroupTypeFilterIsNotSet(t *testing.T) {
@@ -270,13 +306,182 @@ func TestProcessRule(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestProcessFunction(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	parameters := []engine.PadFunctionParameter{{Name: "prefix", Type: "String"}}
+	err := mockedInterpreter.ProcessFunction("greeting", parameters, "String", `$prefix + $returnStr(" world")`)
+	assert.Nil(t, err)
+
+	// the parameters are only bound while the function is called
+	mockedEnv.GetRegisterMap()["prefix"] = BuildStringValue("unchanged")
+
+	gotVal, err := EvalExpr(mockedEnv, "", `$greeting("hello") == "hello world"`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+	assert.Equal(t, BuildStringValue("unchanged"), mockedEnv.GetRegisterMap()["prefix"])
+}
+
+func TestProcessFunction_WhenFunctionCallsPreviousFunction(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("double", []engine.PadFunctionParameter{{Name: "n", Type: "Int"}}, "", "$n * 2")
+	assert.Nil(t, err)
+
+	err = mockedInterpreter.ProcessFunction("isSmall", []engine.PadFunctionParameter{{Name: "n", Type: "Int"}}, "Bool", "$double($n) < 10")
+	assert.Nil(t, err)
+
+	gotVal, err := EvalExpr(mockedEnv, "", "$isSmall(4) && !$isSmall(5)")
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestProcessFunction_WhenCalledWithNamedArguments(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	parameters := []engine.PadFunctionParameter{{Name: "text", Type: "String"}, {Name: "suffix", Type: "String"}}
+	err := mockedInterpreter.ProcessFunction("withSuffix", parameters, "String", `$text + $suffix`)
+	assert.Nil(t, err)
+
+	gotVal, err := EvalExpr(mockedEnv, "", `$withSuffix(suffix: "!", text: "hello") == "hello!"`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestProcessFunction_WhenFunctionIsRecursive(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("loop", []engine.PadFunctionParameter{{Name: "n", Type: "Int"}}, "Int", "1 + $loop($n)")
+
+	assert.EqualError(t, err, "ProcessFunction: type error at line 1, column 5: function loop cannot call itself\n    1 + $loop($n)\n        ^")
+}
+
+func TestProcessFunction_WhenNameIsAlreadyDefined(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("zeroConst", []engine.PadFunctionParameter{}, "Int", "1")
+
+	assert.EqualError(t, err, "ProcessFunction: function zeroConst is already defined")
+}
+
+func TestProcessFunction_WhenParameterTypeIsUnknown(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("isSmall", []engine.PadFunctionParameter{{Name: "n", Type: "Number"}}, "Bool", "$n < 10")
+
+	assert.EqualError(t, err, "ProcessFunction: parameter n of function isSmall: unknown type \"Number\"")
+}
+
+func TestProcessFunction_WhenBodyDoesNotHaveReturnType(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("isSmall", []engine.PadFunctionParameter{{Name: "n", Type: "Int"}}, "Bool", "$n + 10")
+
+	assert.EqualError(t, err, "ProcessFunction: type error at line 1, column 4: body of function isSmall does not have type Bool\n    $n + 10\n       ^")
+}
+
+func TestProcessFunction_WhenBodyHasTypeError(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessFunction("isSmall", []engine.PadFunctionParameter{{Name: "n", Type: "String"}}, "Bool", "$n < 10")
+
+	assert.EqualError(t, err, "ProcessFunction: type error at line 1, column 4: type inference failed\n    $n < 10\n       ^")
+}
+
+func TestProcessConstant(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessConstant("owners", "map[String][]String", `{"api/": ["john"], "web/": ["jane", "mary"]}`)
+	assert.Nil(t, err)
+
+	gotVal, err := EvalExpr(mockedEnv, "", `$owners["web/"] == ["jane", "mary"]`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestProcessConstant_WhenNameIsAlreadyDefined(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessConstant("zeroConst", "Int", "1")
+
+	assert.EqualError(t, err, "ProcessConstant: constant zeroConst is already defined")
+}
+
+func TestProcessConstant_WhenTypeIsUnknown(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessConstant("thresholds", "map[String]Number", `{"small": 1}`)
+
+	assert.EqualError(t, err, "ProcessConstant: type of constant thresholds: unknown type \"Number\"")
+}
+
+func TestProcessConstant_WhenValueDoesNotHaveType(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ProcessConstant("thresholds", "map[String]Int", `{"small": "1"}`)
+
+	assert.EqualError(t, err, "ProcessConstant: type error at line 1, column 1: value of constant thresholds does not have type map[String]Int\n    {\"small\": \"1\"}\n    ^")
+}
+
 func TestEvalExpr_WhenParseFails(t *testing.T) {
 	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
 
 	gotVal, err := EvalExpr(mockedEnv, "", "1 ==")
 
 	assert.False(t, gotVal)
-	assert.EqualError(t, err, "parse error: failed to build AST on input 1 ==")
+	assert.EqualError(t, err, "parse error at line 1, column 5: unexpected end of input\n    1 ==\n        ^")
 }
 
 func TestEvalExpr_WhenTypeInferenceFails(t *testing.T) {
@@ -285,7 +490,7 @@ func TestEvalExpr_WhenTypeInferenceFails(t *testing.T) {
 	gotVal, err := EvalExpr(mockedEnv, "", "1 == \"a\"")
 
 	assert.False(t, gotVal)
-	assert.EqualError(t, err, "type inference failed")
+	assert.EqualError(t, err, "type error at line 1, column 3: type inference failed\n    1 == \"a\"\n      ^")
 }
 
 func TestEvalExpr_WhenExprIsNotBoolType(t *testing.T) {
@@ -294,7 +499,7 @@ func TestEvalExpr_WhenExprIsNotBoolType(t *testing.T) {
 	gotVal, err := EvalExpr(mockedEnv, "", "1")
 
 	assert.False(t, gotVal)
-	assert.EqualError(t, err, "expression 1 is not a condition")
+	assert.EqualError(t, err, "type error at line 1, column 1: expression is not a condition\n    1\n    ^")
 }
 
 func TestEvalExpr(t *testing.T) {
@@ -319,6 +524,94 @@ func TestEvalExpr_OnInterpreter(t *testing.T) {
 	assert.True(t, gotVal)
 }
 
+func TestCompileExpr(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.CompileExpr("", "$zeroConst() == 0")
+	assert.Nil(t, err)
+
+	compiledExpr := mockedEnv.GetCompiledExprs().conditions["$zeroConst() == 0"]
+	gotExpr, err := compileCondition(mockedEnv, "$zeroConst() == 0")
+
+	assert.Nil(t, err)
+	assert.NotNil(t, compiledExpr)
+	assert.Same(t, compiledExpr, gotExpr)
+}
+
+func TestCompileExpr_WhenExprIsNotBoolType(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.CompileExpr("", "$zeroConst()")
+
+	assert.EqualError(t, err, "type error at line 1, column 1: expression is not a condition\n    $zeroConst()\n    ^")
+	assert.Empty(t, mockedEnv.GetCompiledExprs().conditions)
+}
+
+func TestCompileStatement(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	gotStatement, err := mockedInterpreter.CompileStatement("$emptyAction()")
+
+	wantStatement := withPos(BuildFunctionCall(
+		withPos(BuildVariable("emptyAction"), Position{1, 1}).(*Variable),
+		[]Expr{},
+	), Position{1, 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantStatement, gotStatement)
+}
+
+func TestCompileStatement_WhenTypeCheckExecFails(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	gotStatement, err := mockedInterpreter.CompileStatement("$emptyAction(1)")
+
+	assert.Nil(t, gotStatement)
+	assert.EqualError(t, err, "type error at line 1, column 1: type inference failed: mismatch in arg types on emptyAction\n    $emptyAction(1)\n    ^")
+}
+
+func TestExecStatement_WhenStatementIsCompiled(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	var calls int
+	mockedEnv.GetBuiltIns().Actions["emptyAction"].Code = func(e Env, args []Value) error {
+		calls++
+		return nil
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	compiled, err := mockedInterpreter.CompileStatement("$emptyAction()")
+	assert.Nil(t, err)
+
+	// the code of the statement is not parsed again, so it is only used to report the action
+	statement := engine.BuildCompiledStatement("$emptyAction(", "workflows[0].then[0]", compiled)
+
+	err = mockedInterpreter.ExecStatement(statement)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []string{"$emptyAction("}, mockedEnv.GetReport().Actions)
+}
+
 func TestExecProgram_WhenExecStatementFails(t *testing.T) {
 	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
 
@@ -333,7 +626,65 @@ func TestExecProgram_WhenExecStatementFails(t *testing.T) {
 	exitStatus, err := mockedInterpreter.ExecProgram(program)
 
 	assert.Equal(t, engine.ExitStatusFailure, exitStatus)
-	assert.EqualError(t, err, "no type for built-in action. Please check if the mode in the reviewpad.yml file supports it")
+	assert.EqualError(t, err, "type error at line 1, column 1: no type for built-in action. Please check if the mode in the reviewpad.yml file supports it\n    $action()\n    ^")
+}
+
+func TestExecProgram_WhenExecStatementWithPathFails(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	statement := engine.BuildStatementWithPath("$emptyAction(", "workflows[0].then[1]")
+	statements := []*engine.Statement{statement}
+	program := engine.BuildProgram(statements)
+
+	exitStatus, err := mockedInterpreter.ExecProgram(program)
+
+	assert.Equal(t, engine.ExitStatusFailure, exitStatus)
+	assert.EqualError(t, err, "workflows[0].then[1]: parse error at line 1, column 14: unexpected end of input\n    $emptyAction(\n                 ^")
+}
+
+func TestExecProgram_WhenExecStatementFailsAndErrorsAreIgnored(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"failingAction": {
+				Type: BuildFunctionType([]Type{}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("service unavailable")
+				},
+			},
+			"addLabel": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	mockedEnv := MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	statements := []*engine.Statement{
+		engine.BuildStatementWithPath("$failingAction()", "workflows[0].then[0]").WithIgnoreErrors(true),
+		engine.BuildStatementWithPath(`$addLabel("test")`, "workflows[0].then[1]"),
+	}
+	program := engine.BuildProgram(statements)
+
+	exitStatus, err := mockedInterpreter.ExecProgram(program)
+
+	wantWarnings := []string{"action $failingAction() failed and was skipped: workflows[0].then[0]: service unavailable"}
+	gotWarnings := mockedEnv.GetBuiltInsReportedMessages()[SEVERITY_WARNING]
+
+	assert.Nil(t, err)
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+	assert.Equal(t, wantWarnings, gotWarnings)
+	assert.Equal(t, []string{`$addLabel("test")`}, mockedEnv.GetReport().Actions)
 }
 
 func TestExecProgram(t *testing.T) {
@@ -400,7 +751,7 @@ func TestExecStatement_WhenParseFails(t *testing.T) {
 
 	err := mockedInterpreter.ExecStatement(statement)
 
-	assert.EqualError(t, err, "parse error: failed to build AST on input $addLabel(")
+	assert.EqualError(t, err, "parse error at line 1, column 11: unexpected end of input\n    $addLabel(\n              ^")
 }
 
 func TestExecStatement_WhenTypeCheckExecFails(t *testing.T) {
@@ -425,7 +776,7 @@ func TestExecStatement_WhenTypeCheckExecFails(t *testing.T) {
 
 	err := mockedInterpreter.ExecStatement(statement)
 
-	assert.EqualError(t, err, "type inference failed: mismatch in arg types on addLabel")
+	assert.EqualError(t, err, "type error at line 1, column 1: type inference failed: mismatch in arg types on addLabel\n    $addLabel(1)\n    ^")
 }
 
 func TestExecStatement_WhenActionExecFails(t *testing.T) {
@@ -505,6 +856,61 @@ func TestExecStatement(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestReportWarning(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	mockedInterpreter.ReportWarning("rules[0].spec failed and was treated as false")
+
+	wantWarnings := []string{"rules[0].spec failed and was treated as false"}
+
+	assert.Equal(t, wantWarnings, mockedEnv.GetBuiltInsReportedMessages()[SEVERITY_WARNING])
+}
+
+func TestExplainExpr(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	gotResult, gotTrace, err := mockedInterpreter.ExplainExpr("patch", "$zeroConst() > 0")
+
+	assert.Nil(t, err)
+	assert.False(t, gotResult)
+	assert.Regexp(t, `^\$zeroConst\(\) > 0 = false \[.*\]\n  \$zeroConst\(\) = 0 \[.*\]\n    calls zeroConst\(\)\n$`, gotTrace)
+}
+
+func TestExplainExpr_WhenExpressionIsInvalid(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	_, gotTrace, err := mockedInterpreter.ExplainExpr("patch", "$zeroConst()")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "", gotTrace)
+}
+
+func TestReportExplanation(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	mockedInterpreter.ReportExplanation("add-label", "true = true [0s]\n")
+
+	wantExplanations := []Explanation{{Workflow: "add-label", Trace: "true = true [0s]\n"}}
+
+	assert.Equal(t, wantExplanations, mockedEnv.GetReport().Explanations)
+}
+
 func TestReport_WhenFindReportCommentFails(t *testing.T) {
 	mockedPullRequest := GetDefaultMockPullRequestDetailsWith(&github.PullRequest{
 		User: &github.User{Login: github.String("john")},
@@ -6,14 +6,33 @@ package aladino
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 type AladinoLex struct {
+	// source is the full input being parsed
+	source string
+	// input is the part of the source that was not lexed yet
 	input string
 	ast   Expr
+	// pos is the position of the next character in the input
+	pos Position
+	// tokenPos and tokenText describe the last token returned to the parser
+	tokenPos  Position
+	tokenText string
+	err       *Error
+	// hasComments is set once a comment is skipped
+	hasComments bool
+}
+
+func newAladinoLex(input string) *AladinoLex {
+	return &AladinoLex{
+		source: input,
+		input:  input,
+		pos:    Position{Line: 1, Column: 1},
+	}
 }
 
 const EOF = 0
@@ -31,9 +50,13 @@ var tokens = []tokenDef{
 		// YYYY-MM-DD - e.g. 2022-04-05
 		// YYYYMMDDTHH:MM:SS - e.g. 20220405T22:01:50
 		// YYYY-MM-DDTHH:MM:SS - e.g. 2022-04-05T22:01:50
+		// The time can be followed by a timezone, either Z or an offset - e.g. 2022-04-05T22:01:50+01:00
+		// Timestamps without a timezone are in UTC.
 		// Recommended format:
 		// RFC3339 (https://pkg.go.dev/time#pkg-constants)
-		regex: regexp.MustCompile(`^\d{4}-?\d{2}-?\d{2}(T\d{2}:\d{2}:\d{2})?`),
+		// The word boundary keeps longer numbers, such as Unix seconds, from being read as timestamps.
+		// Dates have either both dashes or none, so 2022-1231 is a subtraction.
+		regex: regexp.MustCompile(`^\d{4}(-\d{2}-\d{2}|\d{4})(T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})?)?\b`),
 		kind:  "timestamp",
 		token: TIMESTAMP,
 	},
@@ -42,10 +65,19 @@ var tokens = []tokenDef{
 		// 15 days ago
 		// 3 months ago
 		// 8 hours ago
-		regex: regexp.MustCompile(`^[0-9]+\s(year(s?)|month(s?)|day(s?)|week(s?)|hour(s?)|minute(s?))\sago`),
+		regex: regexp.MustCompile(`^[0-9]+\s(year(s?)|month(s?)|day(s?)|week(s?)|hour(s?)|minute(s?)|second(s?))\sago`),
 		kind:  "relativeTimestamp",
 		token: RELATIVETIMESTAMP,
 	},
+	{
+		// Examples:
+		// 48 hours
+		// 2 weeks
+		// 30 minutes
+		regex: regexp.MustCompile(`^[0-9]+\s(week(s?)|day(s?)|hour(s?)|minute(s?)|second(s?))\b`),
+		kind:  "duration",
+		token: DURATION,
+	},
 	{
 		regex: regexp.MustCompile(`^[0-9]*\.?[0-9]+([eE][-+]?[0-9]+)?`),
 		kind:  "number",
@@ -61,21 +93,79 @@ var tokens = []tokenDef{
 		kind:  "bool",
 		token: FALSE,
 	},
+	{
+		regex: regexp.MustCompile(`^if\b`),
+		kind:  "keyword",
+		token: TK_IF,
+	},
+	{
+		regex: regexp.MustCompile(`^then\b`),
+		kind:  "keyword",
+		token: TK_THEN,
+	},
+	{
+		regex: regexp.MustCompile(`^else\b`),
+		kind:  "keyword",
+		token: TK_ELSE,
+	},
+	{
+		regex: regexp.MustCompile(`^let\b`),
+		kind:  "keyword",
+		token: TK_LET,
+	},
+	{
+		regex: regexp.MustCompile(`^in\b`),
+		kind:  "keyword",
+		token: TK_IN,
+	},
+	{
+		regex: regexp.MustCompile(`^try\b`),
+		kind:  "keyword",
+		token: TK_TRY,
+	},
+	{
+		// Regular expressions are raw strings prefixed by r - e.g. r"^docs/" or r`\.go$`
+		regex: regexp.MustCompile("^r(\"[^\"]*\"|`[^`]*`)"),
+		kind:  "regexLiteral",
+		token: REGEXLITERAL,
+	},
 	{
 		regex: regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*`),
 		kind:  "identifier",
 		token: IDENTIFIER,
 	},
 	{
-		regex: regexp.MustCompile(`^"[^"]*"`),
+		// Double-quoted strings support escape sequences such as \", \n or \u00e9.
+		regex: regexp.MustCompile(`^"(?s:\\.|[^"\\])*"`),
 		kind:  "stringLiteral",
 		token: STRINGLITERAL,
 	},
+	{
+		// Backtick strings are raw: their content is taken as is.
+		regex: regexp.MustCompile("^`[^`]*`"),
+		kind:  "rawStringLiteral",
+		token: STRINGLITERAL,
+	},
 	{
 		regex: regexp.MustCompile(`^(>|<)=?`),
 		kind:  "binop",
 		token: TK_CMPOP,
 	},
+	{
+		regex: regexp.MustCompile(`^=>`),
+		kind:  "arrow",
+		token: TK_ARROW,
+	},
+	{
+		regex: regexp.MustCompile(`^=~`),
+		kind:  "binop",
+		token: TK_MATCH,
+	},
+	{
+		regex: regexp.MustCompile(`^!~`),
+		kind:  "binop",
+		token: TK_NOT_MATCH,
+	},
 	{
 		regex: regexp.MustCompile(`^==`),
 		kind:  "binop",
@@ -101,16 +191,42 @@ var tokens = []tokenDef{
 		kind:  "binop",
 		token: TK_OR,
 	},
+	{
+		regex: regexp.MustCompile(`^\+`),
+		kind:  "binop",
+		token: TK_PLUS,
+	},
+	{
+		regex: regexp.MustCompile(`^-`),
+		kind:  "binop",
+		token: TK_MINUS,
+	},
+	{
+		regex: regexp.MustCompile(`^\*`),
+		kind:  "binop",
+		token: TK_MULT,
+	},
+	{
+		regex: regexp.MustCompile(`^/`),
+		kind:  "binop",
+		token: TK_DIV,
+	},
+	{
+		regex: regexp.MustCompile(`^%`),
+		kind:  "binop",
+		token: TK_MOD,
+	},
 }
 
 func (l *AladinoLex) Lex(lval *AladinoSymType) int {
-	// fmt.Printf("lex: input: %v\n", l.input)
-	// Skip spaces.
-	for ; len(l.input) > 0 && isSpace(l.input[0]); l.input = l.input[1:] {
-	}
+	l.skipSpacesAndComments()
+
+	l.tokenPos = l.pos
+	lval.pos = l.pos
 
 	// Check if the input has ended.
 	if len(l.input) == 0 {
+		l.tokenText = ""
 		return EOF
 	}
 
@@ -123,34 +239,140 @@ func (l *AladinoLex) Lex(lval *AladinoSymType) int {
 		}
 
 		switch tokDef.kind {
+		case "timestamp":
+			if _, err := parseTimestamp(str); err != nil {
+				// 8 digits that are not a date, e.g. 20221399, are a number.
+				if isNumber(str) {
+					continue
+				}
+				return l.fail("invalid timestamp %q", str)
+			}
+			lval.str = str
 		case "number":
 			num, err := strconv.Atoi(str)
 			if err != nil {
-				fmt.Printf("error in atoi %v\n", err)
-				log.Fatal(err)
+				return l.fail("invalid number %q", str)
 			}
 			lval.int = num
 		case "stringLiteral":
 			// Pass string content to the parser.
+			content, err := unquote(str)
+			if err != nil {
+				return l.fail("invalid escape sequence in string literal")
+			}
+			lval.str = content
+		case "rawStringLiteral":
 			lval.str = str[1 : len(str)-1]
+		case "regexLiteral":
+			lval.str = str[2 : len(str)-1]
 		default:
 			lval.str = str
 		}
 
-		l.input = l.input[len(str):]
+		l.tokenText = str
+		l.advance(str)
 		return tokDef.token
 	}
 
+	if l.input[0] == '"' || l.input[0] == '`' {
+		return l.fail("unterminated string literal")
+	}
+
 	// Otherwise return the next letter.
 	ret := int(l.input[0])
-	l.input = l.input[1:]
+	l.tokenText = l.input[:1]
+	l.advance(l.tokenText)
 	return ret
 }
 
+// advance consumes str from the input and updates the current position.
+func (l *AladinoLex) advance(str string) {
+	for _, r := range str {
+		if r == '\n' {
+			l.pos.Line++
+			l.pos.Column = 1
+		} else {
+			l.pos.Column++
+		}
+	}
+
+	l.input = l.input[len(str):]
+}
+
+// skipSpacesAndComments consumes the whitespace and the line comments
+// (starting with # or //) found before the next token.
+func (l *AladinoLex) skipSpacesAndComments() {
+	for len(l.input) > 0 {
+		switch {
+		case isSpace(l.input[0]):
+			l.advance(l.input[:1])
+		case strings.HasPrefix(l.input, "#") || strings.HasPrefix(l.input, "//"):
+			end := strings.IndexByte(l.input, '\n')
+			if end == -1 {
+				end = len(l.input)
+			}
+			l.hasComments = true
+			l.advance(l.input[:end])
+		default:
+			return
+		}
+	}
+}
+
+// fail records a lexical error at the current token and stops the parsing.
+func (l *AladinoLex) fail(format string, a ...interface{}) int {
+	if l.err == nil {
+		l.err = parseError(l.tokenPos, l.source, format, a...)
+	}
+	return EOF
+}
+
+// unquote returns the content of a double-quoted string literal
+// with its escape sequences replaced by the characters they represent.
+func unquote(str string) (string, error) {
+	content := str[1 : len(str)-1]
+
+	var sb strings.Builder
+	for len(content) > 0 {
+		r, _, tail, err := strconv.UnquoteChar(content, '"')
+		if err != nil {
+			return "", err
+		}
+		sb.WriteRune(r)
+		content = tail
+	}
+
+	return sb.String(), nil
+}
+
+// Error is called by the parser on a syntax error.
+// The error is reported at the last token returned by the lexer.
 func (l *AladinoLex) Error(s string) {
-	fmt.Printf("syntax error on %s\n", l.input)
+	if l.err != nil {
+		return
+	}
+
+	unexpected := "end of input"
+	if l.tokenText != "" {
+		unexpected = fmt.Sprintf("%q", l.tokenText)
+	}
+
+	l.err = parseError(l.tokenPos, l.source, "unexpected %v", unexpected)
 }
 
 func isSpace(c byte) bool {
-	return c == ' '
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	}
+	return false
+}
+
+func isNumber(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
 }
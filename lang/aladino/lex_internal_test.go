@@ -0,0 +1,42 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLex_WhenTokenSpansMultipleLines(t *testing.T) {
+	lex := newAladinoLex("\"a\nb\" == $x")
+
+	var lval AladinoSymType
+	positions := make([]Position, 0)
+	for tok := lex.Lex(&lval); tok != EOF; tok = lex.Lex(&lval) {
+		positions = append(positions, lval.pos)
+	}
+
+	// tokens: "a\nb" == $ x
+	wantPositions := []Position{{1, 1}, {2, 4}, {2, 7}, {2, 8}}
+
+	assert.Equal(t, wantPositions, positions)
+}
+
+func TestLex_WhenInputHasWhitespaceAndComments(t *testing.T) {
+	lex := newAladinoLex("\t$x # comment\r\n// another comment\n  && $y // trailing")
+
+	var lval AladinoSymType
+	positions := make([]Position, 0)
+	for tok := lex.Lex(&lval); tok != EOF; tok = lex.Lex(&lval) {
+		positions = append(positions, lval.pos)
+	}
+
+	// tokens: $ x && $ y
+	wantPositions := []Position{{1, 2}, {1, 3}, {3, 3}, {3, 6}, {3, 7}}
+
+	assert.Nil(t, lex.err)
+	assert.Equal(t, wantPositions, positions)
+}
@@ -11,12 +11,29 @@ import (
 
 func Parse(input string) (Expr, error) {
 	input = strings.TrimRight(input, "\n")
-	lex := &AladinoLex{input: input}
+	lex := newAladinoLex(input)
 	res := AladinoParse(lex)
 
+	// A lexical error stops the parsing as if the input had ended,
+	// so it must be checked even when the parser succeeds.
+	if lex.err != nil {
+		return nil, lex.err
+	}
+
 	if res != 0 {
 		return nil, fmt.Errorf("parse error: failed to build AST on input %v", input)
 	}
 
 	return lex.ast, nil
 }
+
+// hasComments checks if the source of an expression has comments.
+// The comments in string literals are part of the strings, so they are not counted.
+func hasComments(input string) bool {
+	lex := newAladinoLex(input)
+	var lval AladinoSymType
+	for lex.Lex(&lval) != EOF {
+	}
+
+	return lex.hasComments
+}
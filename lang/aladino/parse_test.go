@@ -5,16 +5,18 @@
 package aladino
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParse_WhenSingleLine(t *testing.T) {
 	input := `$addLabel("small")`
-	wantExpr := BuildFunctionCall(
-		BuildVariable("addLabel"),
-		[]Expr{BuildStringConst("small")},
-	)
+	wantExpr := withPos(BuildFunctionCall(
+		withPos(BuildVariable("addLabel"), Position{1, 1}).(*Variable),
+		[]Expr{withPos(BuildStringConst("small"), Position{1, 11})},
+	), Position{1, 1})
 
 	gotExpr, err := Parse(input)
 	assert.Nil(t, err)
@@ -25,12 +27,406 @@ func TestParse_WhenMultilineLine(t *testing.T) {
 	input := `$addLabel("medium multiline")
 
 `
+	wantExpr := withPos(BuildFunctionCall(
+		withPos(BuildVariable("addLabel"), Position{1, 1}).(*Variable),
+		[]Expr{withPos(BuildStringConst("medium multiline"), Position{1, 11})},
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenArithmeticOperatorsHavePrecedence(t *testing.T) {
+	input := `$size() - $fileCount() * 10 > 200`
+	wantExpr := withPos(BuildGreaterThanOp(
+		withPos(BuildMinusOp(
+			withPos(BuildFunctionCall(withPos(BuildVariable("size"), Position{1, 1}).(*Variable), []Expr{}), Position{1, 1}),
+			withPos(BuildMultOp(
+				withPos(BuildFunctionCall(withPos(BuildVariable("fileCount"), Position{1, 11}).(*Variable), []Expr{}), Position{1, 11}),
+				withPos(BuildIntConst(10), Position{1, 26}),
+			), Position{1, 24}),
+		), Position{1, 9}),
+		withPos(BuildIntConst(200), Position{1, 31}),
+	), Position{1, 29})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenArithmeticOperatorsAreLeftAssociative(t *testing.T) {
+	input := `10 - 4 - 3 % 2`
+	wantExpr := BuildMinusOp(
+		BuildMinusOp(BuildIntConst(10), BuildIntConst(4)),
+		BuildModOp(BuildIntConst(3), BuildIntConst(2)),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenUnaryMinus(t *testing.T) {
+	input := `-2 * 3`
+	wantExpr := BuildMultOp(
+		BuildNegOp(BuildIntConst(2)),
+		BuildIntConst(3),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenSyntaxErrorAtEndOfInput(t *testing.T) {
+	input := `$size() >`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 10: unexpected end of input\n    $size() >\n             ^")
+}
+
+func TestParse_WhenSyntaxErrorOnToken(t *testing.T) {
+	input := `$isDraft() && || $isDraft()`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &Error{
+		Kind:   PARSE_ERROR,
+		Pos:    Position{1, 15},
+		Msg:    `unexpected "||"`,
+		Source: input,
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_WhenStringHasEscapeSequences(t *testing.T) {
+	input := `$comment("say \"hi\"\né\t\\")`
 	wantExpr := BuildFunctionCall(
-		BuildVariable("addLabel"),
-		[]Expr{BuildStringConst("medium multiline")},
+		BuildVariable("comment"),
+		[]Expr{BuildStringConst("say \"hi\"\né\t\\")},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenRawString(t *testing.T) {
+	input := "$comment(`a \\n \"quoted\"\nmessage`)"
+	wantExpr := BuildFunctionCall(
+		BuildVariable("comment"),
+		[]Expr{BuildStringConst("a \\n \"quoted\"\nmessage")},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenMultilineExpressionWithComments(t *testing.T) {
+	input := `# the pull request is small
+$size() < 30 // lines changed
+	&&
+	$isDraft() == false # not ready yet
+`
+	wantExpr := withPos(BuildAndOp(
+		withPos(BuildLessThanOp(
+			withPos(BuildFunctionCall(withPos(BuildVariable("size"), Position{2, 1}).(*Variable), []Expr{}), Position{2, 1}),
+			withPos(BuildIntConst(30), Position{2, 11}),
+		), Position{2, 9}),
+		withPos(BuildEqOp(
+			withPos(BuildFunctionCall(withPos(BuildVariable("isDraft"), Position{4, 2}).(*Variable), []Expr{}), Position{4, 2}),
+			withPos(BuildBoolConst(false), Position{4, 16}),
+		), Position{4, 13}),
+	), Position{3, 2})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenStringHasInvalidEscapeSequence(t *testing.T) {
+	input := `$comment("a\qb")`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 10: invalid escape sequence in string literal\n    $comment(\"a\\qb\")\n             ^")
+}
+
+func TestParse_WhenStringIsUnterminated(t *testing.T) {
+	input := `$comment("hello)`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 10: unterminated string literal\n    $comment(\"hello)\n             ^")
+}
+
+func TestParse_WhenNumberIsInvalid(t *testing.T) {
+	input := `$size() > 1.5`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 11: invalid number \"1.5\"\n    $size() > 1.5\n              ^")
+}
+
+func TestParse_WhenLambda(t *testing.T) {
+	input := `($file: String, $prefixes: []String => $startsWith($file, "docs/"))`
+	wantExpr := withPos(BuildLambda(
+		[]Expr{
+			withPos(BuildTypedExpr(withPos(BuildVariable("file"), Position{1, 2}), BuildStringType()), Position{1, 2}),
+			withPos(BuildTypedExpr(withPos(BuildVariable("prefixes"), Position{1, 17}), BuildArrayOfType(BuildStringType())), Position{1, 17}),
+		},
+		withPos(BuildFunctionCall(
+			withPos(BuildVariable("startsWith"), Position{1, 40}).(*Variable),
+			[]Expr{
+				withPos(BuildVariable("file"), Position{1, 52}),
+				withPos(BuildStringConst("docs/"), Position{1, 59}),
+			},
+		), Position{1, 40}),
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambdaParamHasUnknownType(t *testing.T) {
+	input := `($file: Folder => true)`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 9: unknown type \"Folder\"\n    ($file: Folder => true)\n            ^")
+}
+
+func TestParse_WhenFieldAccess(t *testing.T) {
+	input := `$lastCommit().author == "john"`
+	wantExpr := withPos(BuildEqOp(
+		withPos(BuildFieldAccess(
+			withPos(BuildFunctionCall(withPos(BuildVariable("lastCommit"), Position{1, 1}).(*Variable), []Expr{}), Position{1, 1}),
+			"author",
+		), Position{1, 14}),
+		withPos(BuildStringConst("john"), Position{1, 25}),
+	), Position{1, 22})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenMapIsIndexed(t *testing.T) {
+	input := `{"api/": 2, "web/": 1}["api/"]`
+	wantExpr := withPos(BuildIndexAccess(
+		withPos(BuildMap([]MapEntry{
+			BuildMapEntry(withPos(BuildStringConst("api/"), Position{1, 2}), withPos(BuildIntConst(2), Position{1, 10})),
+			BuildMapEntry(withPos(BuildStringConst("web/"), Position{1, 13}), withPos(BuildIntConst(1), Position{1, 21})),
+		}), Position{1, 1}),
+		withPos(BuildStringConst("api/"), Position{1, 24}),
+	), Position{1, 23})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambdaParamHasMapType(t *testing.T) {
+	input := `($owners: map[String][]String => true)`
+	wantExpr := BuildLambda(
+		[]Expr{BuildTypedExpr(BuildVariable("owners"), BuildMapType(BuildArrayOfType(BuildStringType())))},
+		BuildBoolConst(true),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenTimestampHasTimezone(t *testing.T) {
+	input := `2022-04-05T22:01:50+01:00 - 2022-04-05T21:01:50Z`
+	wantExpr := BuildMinusOp(
+		&TimeConst{value: int(time.Date(2022, 4, 5, 21, 1, 50, 0, time.UTC).Unix())},
+		&TimeConst{value: int(time.Date(2022, 4, 5, 21, 1, 50, 0, time.UTC).Unix())},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenDuration(t *testing.T) {
+	input := `$durationSince($createdAt()) > 2 days`
+	wantExpr := BuildGreaterThanOp(
+		BuildFunctionCall(
+			BuildVariable("durationSince"),
+			[]Expr{BuildFunctionCall(BuildVariable("createdAt"), []Expr{})},
+		),
+		&DurationConst{value: 2 * 24 * 60 * 60},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenTimestampIsInvalid(t *testing.T) {
+	input := `$createdAt() < 2022-13-01`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 16: invalid timestamp \"2022-13-01\"\n    $createdAt() < 2022-13-01\n                   ^")
+}
+
+func TestParse_WhenNumberLooksLikeTimestamp(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		wantExpr Expr
+	}{
+		"when 8 digits are not a date": {
+			input:    `$size() > 20221399`,
+			wantExpr: BuildGreaterThanOp(BuildFunctionCall(BuildVariable("size"), []Expr{}), BuildIntConst(20221399)),
+		},
+		"when 8 digits are not a date in arithmetic": {
+			input:    `20221399 - 1`,
+			wantExpr: BuildMinusOp(BuildIntConst(20221399), BuildIntConst(1)),
+		},
+		"when subtraction is not spaced": {
+			input:    `2022-1231`,
+			wantExpr: BuildMinusOp(BuildIntConst(2022), BuildIntConst(1231)),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotExpr, err := Parse(test.input)
+			assert.Nil(t, err)
+			assert.True(t, test.wantExpr.equals(gotExpr))
+		})
+	}
+}
+
+func TestParse_WhenMatchOperators(t *testing.T) {
+	input := "$title() =~ r`^feat` && $title() !~ r\"wip\""
+	wantExpr := BuildAndOp(
+		BuildMatchOp(BuildFunctionCall(BuildVariable("title"), []Expr{}), BuildRegexConst("^feat")),
+		BuildNotMatchOp(BuildFunctionCall(BuildVariable("title"), []Expr{}), BuildRegexConst("wip")),
 	)
 
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenRegexHasBackslashes(t *testing.T) {
+	input := `r"\.go$"`
+	wantExpr := withPos(BuildRegexConst(`\.go$`), Position{1, 1})
+
 	gotExpr, err := Parse(input)
 	assert.Nil(t, err)
 	assert.Equal(t, wantExpr, gotExpr)
 }
+
+func TestParse_WhenNamedArguments(t *testing.T) {
+	input := `$assignReviewer(["john"], total: 2)`
+	wantExpr := withPos(BuildFunctionCallWithArgNames(
+		withPos(BuildVariable("assignReviewer"), Position{1, 1}).(*Variable),
+		[]Expr{
+			withPos(BuildArray([]Expr{withPos(BuildStringConst("john"), Position{1, 18})}), Position{1, 17}),
+			withPos(BuildIntConst(2), Position{1, 34}),
+		},
+		[]string{"", "total"},
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenPositionalArgumentFollowsNamedArgument(t *testing.T) {
+	input := `$assignReviewer(total: 2, ["john"])`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 27: positional argument after named arguments\n    $assignReviewer(total: 2, [\"john\"])\n                              ^")
+}
+
+func TestParse_WhenNamedArgumentIsRepeated(t *testing.T) {
+	input := `$merge(method: "squash", method: "rebase")`
+
+	gotExpr, err := Parse(input)
+
+	assert.Nil(t, gotExpr)
+	assert.EqualError(t, err, "parse error at line 1, column 26: argument method is given more than once\n    $merge(method: \"squash\", method: \"rebase\")\n                             ^")
+}
+
+func TestParse_WhenConditional(t *testing.T) {
+	input := `if $isDraft() then "draft" else "ready"`
+	wantExpr := withPos(BuildConditional(
+		withPos(BuildFunctionCall(withPos(BuildVariable("isDraft"), Position{1, 4}).(*Variable), []Expr{}), Position{1, 4}),
+		withPos(BuildStringConst("draft"), Position{1, 20}),
+		withPos(BuildStringConst("ready"), Position{1, 33}),
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenElseBranchExtendsAsFarAsPossible(t *testing.T) {
+	input := `if true then 1 else 2 + 3`
+	wantExpr := BuildConditional(
+		BuildBoolConst(true),
+		BuildIntConst(1),
+		BuildPlusOp(BuildIntConst(2), BuildIntConst(3)),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
+
+func TestParse_WhenLet(t *testing.T) {
+	input := `let $n = $size() in $n > 10 && $n < 100`
+	wantExpr := withPos(BuildLet(
+		withPos(BuildVariable("n"), Position{1, 5}).(*Variable),
+		withPos(BuildFunctionCall(withPos(BuildVariable("size"), Position{1, 10}).(*Variable), []Expr{}), Position{1, 10}),
+		withPos(BuildAndOp(
+			withPos(BuildGreaterThanOp(withPos(BuildVariable("n"), Position{1, 21}), withPos(BuildIntConst(10), Position{1, 26})), Position{1, 24}),
+			withPos(BuildLessThanOp(withPos(BuildVariable("n"), Position{1, 32}), withPos(BuildIntConst(100), Position{1, 37})), Position{1, 35}),
+		), Position{1, 29}),
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenTry(t *testing.T) {
+	input := `try($hasFileName("go.mod"), false)`
+	wantExpr := withPos(BuildTry(
+		withPos(BuildFunctionCall(withPos(BuildVariable("hasFileName"), Position{1, 5}).(*Variable), []Expr{withPos(BuildStringConst("go.mod"), Position{1, 18})}), Position{1, 5}),
+		withPos(BuildBoolConst(false), Position{1, 29}),
+	), Position{1, 1})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenKeywordIsPrefixOfIdentifier(t *testing.T) {
+	input := `$info($index)`
+	wantExpr := BuildFunctionCall(BuildVariable("info"), []Expr{BuildVariable("index")})
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.True(t, wantExpr.equals(gotExpr))
+}
@@ -13,28 +13,119 @@ func setAST(l AladinoLexer, root Expr) {
 	l.(*AladinoLex).ast = root
 }
 
+// buildType returns the type with the given name.
+// Unknown types are reported as parse errors.
+func buildType(l AladinoLexer, name string, pos Position) Type {
+	ty, err := parseType(name)
+	if err != nil {
+		lex := l.(*AladinoLex)
+		if lex.err == nil {
+			lex.err = parseError(pos, lex.source, "%v", err)
+		}
+	}
+
+	return ty
+}
+
+// buildMapType returns the type map[key]value.
+// Maps can only have string keys.
+func buildMapType(l AladinoLexer, name, key string, value Type, pos Position) Type {
+	if name != "map" || key != "String" {
+		lex := l.(*AladinoLex)
+		if lex.err == nil {
+			lex.err = parseError(pos, lex.source, "unknown type \"%v[%v]\"", name, key)
+		}
+	}
+
+	return BuildMapType(value)
+}
+
+// argument is an argument of a function call, which may be given by name - e.g. total: 2.
+type argument struct {
+	name string
+	expr Expr
+	pos  Position
+}
+
+// buildFunctionCall returns the call to the function with the given arguments.
+// Named arguments must come after the positional ones and can only be given once.
+func buildFunctionCall(l AladinoLexer, name *Variable, args []argument) *FunctionCall {
+	lex := l.(*AladinoLex)
+
+	exprs := make([]Expr, len(args))
+	argNames := make([]string, len(args))
+	hasNamedArgs := false
+	for i, arg := range args {
+		exprs[i] = arg.expr
+		argNames[i] = arg.name
+
+		if arg.name == "" {
+			if hasNamedArgs && lex.err == nil {
+				lex.err = parseError(arg.expr.Pos(), lex.source, "positional argument after named arguments")
+			}
+			continue
+		}
+
+		for _, argName := range argNames[:i] {
+			if argName == arg.name && lex.err == nil {
+				lex.err = parseError(arg.pos, lex.source, "argument %v is given more than once", arg.name)
+			}
+		}
+
+		hasNamedArgs = true
+	}
+
+	if !hasNamedArgs {
+		return BuildFunctionCall(name, exprs)
+	}
+
+	return BuildFunctionCallWithArgNames(name, exprs, argNames)
+}
+
 type AladinoSymType struct {
-	yys     int
-	str     string
-	int     int
-	ast     Expr
-	astList []Expr
-	bool    bool
+	yys       int
+	str       string
+	int       int
+	ast       Expr
+	astList   []Expr
+	entry     MapEntry
+	entryList []MapEntry
+	arg       argument
+	argList   []argument
+	typ       Type
+	bool      bool
+	pos       Position
 }
 
 const TIMESTAMP = 57346
 const RELATIVETIMESTAMP = 57347
-const IDENTIFIER = 57348
-const STRINGLITERAL = 57349
-const TK_CMPOP = 57350
-const NUMBER = 57351
-const TRUE = 57352
-const FALSE = 57353
-const TK_OR = 57354
-const TK_AND = 57355
-const TK_EQ = 57356
-const TK_NEQ = 57357
-const TK_NOT = 57358
+const DURATION = 57348
+const IDENTIFIER = 57349
+const STRINGLITERAL = 57350
+const REGEXLITERAL = 57351
+const TK_CMPOP = 57352
+const NUMBER = 57353
+const TRUE = 57354
+const FALSE = 57355
+const TK_ARROW = 57356
+const TK_MATCH = 57357
+const TK_NOT_MATCH = 57358
+const TK_IF = 57359
+const TK_THEN = 57360
+const TK_LET = 57361
+const TK_TRY = 57362
+const TK_ELSE = 57363
+const TK_IN = 57364
+const TK_OR = 57365
+const TK_AND = 57366
+const TK_EQ = 57367
+const TK_NEQ = 57368
+const TK_PLUS = 57369
+const TK_MINUS = 57370
+const TK_MULT = 57371
+const TK_DIV = 57372
+const TK_MOD = 57373
+const TK_NOT = 57374
 
 var AladinoToknames = [...]string{
 	"$end",
@@ -42,23 +133,44 @@ var AladinoToknames = [...]string{
 	"$unk",
 	"TIMESTAMP",
 	"RELATIVETIMESTAMP",
+	"DURATION",
 	"IDENTIFIER",
 	"STRINGLITERAL",
+	"REGEXLITERAL",
 	"TK_CMPOP",
 	"NUMBER",
 	"TRUE",
 	"FALSE",
+	"TK_ARROW",
+	"TK_MATCH",
+	"TK_NOT_MATCH",
+	"TK_IF",
+	"TK_THEN",
+	"TK_LET",
+	"TK_TRY",
+	"TK_ELSE",
+	"TK_IN",
 	"TK_OR",
 	"TK_AND",
 	"TK_EQ",
 	"TK_NEQ",
+	"TK_PLUS",
+	"TK_MINUS",
+	"TK_MULT",
+	"TK_DIV",
+	"TK_MOD",
 	"TK_NOT",
-	"'('",
-	"')'",
+	"'.'",
 	"'['",
 	"']'",
+	"'('",
+	"')'",
+	"'{'",
+	"'}'",
 	"'$'",
+	"'='",
 	"','",
+	"':'",
 }
 
 var AladinoStatenames = [...]string{}
@@ -69,7 +181,7 @@ const AladinoInitialStackSize = 16
 
 /*  start  of  programs  */
 
-var AladinoExca = [...]int{
+var AladinoExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -77,74 +189,151 @@ var AladinoExca = [...]int{
 
 const AladinoPrivate = 57344
 
-const AladinoLast = 69
-
-var AladinoAct = [...]int{
-	20, 5, 6, 29, 8, 34, 7, 11, 12, 31,
-	22, 1, 0, 3, 4, 17, 9, 0, 10, 14,
-	13, 15, 16, 21, 2, 0, 0, 18, 19, 30,
-	0, 32, 33, 0, 0, 0, 0, 23, 24, 25,
-	26, 27, 17, 0, 0, 0, 14, 13, 15, 16,
-	17, 0, 28, 17, 14, 13, 15, 16, 13, 15,
-	16, 17, 0, 0, 0, 0, 0, 15, 16,
+const AladinoLast = 477
+
+var AladinoAct = [...]int8{
+	41, 2, 92, 84, 34, 35, 36, 42, 40, 37,
+	72, 78, 98, 97, 44, 70, 89, 78, 46, 66,
+	80, 49, 50, 51, 52, 53, 54, 55, 56, 57,
+	58, 59, 60, 47, 62, 27, 28, 29, 30, 31,
+	69, 32, 33, 29, 30, 31, 96, 32, 33, 75,
+	112, 6, 7, 8, 87, 10, 11, 72, 9, 15,
+	16, 48, 93, 103, 17, 77, 18, 19, 32, 33,
+	102, 44, 83, 86, 88, 4, 79, 81, 82, 3,
+	67, 12, 64, 5, 109, 13, 95, 14, 74, 94,
+	100, 101, 65, 61, 45, 1, 85, 43, 86, 105,
+	106, 104, 39, 0, 24, 0, 110, 0, 111, 25,
+	26, 0, 0, 0, 0, 113, 0, 21, 20, 22,
+	23, 27, 28, 29, 30, 31, 0, 32, 33, 0,
+	0, 6, 7, 8, 0, 10, 11, 71, 9, 15,
+	16, 0, 0, 0, 17, 0, 18, 19, 0, 0,
+	0, 0, 0, 0, 0, 4, 0, 0, 0, 3,
+	0, 12, 0, 5, 24, 13, 0, 14, 0, 25,
+	26, 0, 0, 0, 0, 0, 0, 21, 20, 22,
+	23, 27, 28, 29, 30, 31, 24, 32, 33, 0,
+	0, 25, 26, 0, 0, 0, 90, 0, 0, 21,
+	20, 22, 23, 27, 28, 29, 30, 31, 0, 32,
+	33, 0, 6, 7, 8, 0, 10, 11, 68, 9,
+	15, 16, 0, 0, 0, 17, 0, 18, 19, 0,
+	0, 0, 0, 0, 0, 0, 4, 0, 0, 0,
+	3, 0, 12, 0, 5, 24, 13, 0, 38, 0,
+	25, 26, 0, 0, 0, 0, 0, 0, 21, 20,
+	22, 23, 27, 28, 29, 30, 31, 0, 32, 33,
+	24, 0, 108, 0, 0, 25, 26, 0, 0, 0,
+	0, 0, 0, 21, 20, 22, 23, 27, 28, 29,
+	30, 31, 0, 32, 33, 24, 0, 91, 0, 0,
+	25, 26, 0, 0, 0, 0, 0, 0, 21, 20,
+	22, 23, 27, 28, 29, 30, 31, 0, 32, 33,
+	24, 0, 63, 0, 0, 25, 26, 0, 0, 0,
+	0, 0, 0, 21, 20, 22, 23, 27, 28, 29,
+	30, 31, 24, 32, 33, 76, 0, 25, 26, 0,
+	0, 0, 0, 0, 107, 21, 20, 22, 23, 27,
+	28, 29, 30, 31, 24, 32, 33, 0, 0, 25,
+	26, 0, 0, 0, 0, 99, 0, 21, 20, 22,
+	23, 27, 28, 29, 30, 31, 24, 32, 33, 0,
+	0, 25, 26, 0, 73, 0, 0, 0, 0, 21,
+	20, 22, 23, 27, 28, 29, 30, 31, 24, 32,
+	33, 0, 0, 25, 26, 0, 0, 0, 0, 0,
+	0, 21, 20, 22, 23, 27, 28, 29, 30, 31,
+	24, 32, 33, 0, 0, 25, 26, 0, 0, 0,
+	0, 0, 0, 0, 20, 22, 23, 27, 28, 29,
+	30, 31, 24, 32, 33, 0, 0, 25, 26, 0,
+	0, 0, 0, 0, 0, 0, 0, 22, 23, 27,
+	28, 29, 30, 31, 0, 32, 33,
 }
 
-var AladinoPact = [...]int{
-	-3, -1000, 42, -3, -3, -1000, -1000, -1000, -1000, -3,
-	4, -1000, -1000, -3, -3, -3, -3, -3, -1000, 34,
-	-17, 7, -8, 53, 45, -1000, -1000, -1000, -1000, -1000,
-	-3, -3, -1000, -13, -1000,
+var AladinoPact = [...]int16{
+	127, -1000, 398, 127, 127, 208, -1000, -1000, -1000, -1000,
+	-1000, -1000, 127, 127, 87, -1000, -1000, 127, -7, 25,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 86, 127, 35, 35, 285, 68, 85, -23,
+	45, 176, 1, -27, 94, 21, 376, 81, 127, 442,
+	420, 8, 8, 8, 8, 8, 14, 14, 35, 35,
+	35, -1000, 310, -1000, 127, -26, -20, -1000, 127, -1000,
+	127, 127, 47, 127, -25, 154, -1000, 260, 55, -1000,
+	79, -1000, -1000, 398, 9, -29, 398, -31, 354, 127,
+	127, -1000, -1000, 36, 28, -32, -1000, 47, 127, 127,
+	332, 235, 77, 55, -1000, 398, 398, 127, -1000, 15,
+	-1000, 398, 55, -1000,
 }
 
-var AladinoPgo = [...]int{
-	0, 23, 0, 11,
+var AladinoPgo = [...]int8{
+	0, 0, 8, 9, 102, 2, 97, 7, 96, 3,
+	95,
 }
 
-var AladinoR1 = [...]int{
-	0, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2,
+var AladinoR1 = [...]int8{
+	0, 10, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 3, 4, 5, 5,
+	5, 7, 7, 7, 6, 9, 9, 9, 8, 8,
+	2, 2, 2,
 }
 
-var AladinoR2 = [...]int{
-	0, 1, 2, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 2, 1, 1, 5, 3, 1,
-	0,
+var AladinoR2 = [...]int8{
+	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 3, 5,
+	1, 1, 1, 1, 1, 1, 3, 3, 2, 1,
+	1, 6, 7, 6, 5, 3, 1, 4, 1, 3,
+	5, 3, 1, 0, 3, 3, 1, 0, 1, 3,
+	3, 1, 0,
 }
 
-var AladinoChk = [...]int{
-	-1000, -3, -1, 16, 17, 4, 5, 9, 7, 19,
-	21, 10, 11, 13, 12, 14, 15, 8, -1, -1,
-	-2, -1, 6, -1, -1, -1, -1, -1, 18, 20,
-	22, 17, -2, -2, 18,
+var AladinoChk = [...]int16{
+	-1000, -10, -1, 32, 28, 36, 4, 5, 6, 11,
+	8, 9, 34, 38, 40, 12, 13, 17, 19, 20,
+	24, 23, 25, 26, 10, 15, 16, 27, 28, 29,
+	30, 31, 33, 34, -1, -1, -1, -3, 40, -4,
+	-2, -1, -7, -6, -1, 7, -1, 40, 36, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 7, -1, 37, 14, 7, 42, 35, 42, 39,
+	42, 43, 36, 18, 7, -1, 35, -1, 43, -3,
+	40, -2, -7, -1, -9, -8, -1, 7, -1, 41,
+	42, 37, -5, 7, 34, 7, 37, 42, 43, 21,
+	-1, -1, 34, 35, -9, -1, -1, 22, 37, 7,
+	-5, -1, 35, -5,
 }
 
-var AladinoDef = [...]int{
-	0, -2, 1, 0, 0, 9, 10, 11, 12, 20,
-	0, 15, 16, 0, 0, 0, 0, 0, 2, 0,
-	0, 19, 14, 3, 4, 5, 6, 7, 8, 13,
-	20, 20, 18, 0, 17,
+var AladinoDef = [...]int8{
+	0, -2, 1, 0, 0, 0, 20, 21, 22, 23,
+	24, 25, 52, 43, 0, 29, 30, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2, 3, 0, 0, 0, 36,
+	0, 51, 0, 42, 0, 28, 0, 0, 0, 4,
+	5, 6, 7, 8, 9, 10, 11, 12, 13, 14,
+	15, 16, 0, 18, 0, 28, 0, 26, 52, 27,
+	43, 0, 47, 0, 0, 0, 17, 0, 0, 35,
+	0, 50, 41, 44, 0, 46, 48, 0, 0, 0,
+	0, 19, 37, 38, 0, 0, 34, 47, 0, 0,
+	0, 0, 0, 0, 45, 49, 31, 0, 33, 0,
+	39, 32, 0, 40,
 }
 
-var AladinoTok1 = [...]int{
+var AladinoTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 21, 3, 3, 3,
-	17, 18, 3, 3, 22, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 40, 3, 3, 3,
+	36, 37, 3, 3, 42, 3, 33, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 43, 3,
+	3, 41, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 34, 3, 35, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 19, 3, 20,
+	3, 3, 3, 38, 3, 39,
 }
 
-var AladinoTok2 = [...]int{
+var AladinoTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
-	12, 13, 14, 15, 16,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32,
 }
 
-var AladinoTok3 = [...]int{
+var AladinoTok3 = [...]int8{
 	0,
 }
 
@@ -224,9 +413,9 @@ func AladinoErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := AladinoPact[state]
+	base := int(AladinoPact[state])
 	for tok := TOKSTART; tok-1 < len(AladinoToknames); tok++ {
-		if n := base + tok; n >= 0 && n < AladinoLast && AladinoChk[AladinoAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < AladinoLast && int(AladinoChk[int(AladinoAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -236,13 +425,13 @@ func AladinoErrorMessage(state, lookAhead int) string {
 
 	if AladinoDef[state] == -2 {
 		i := 0
-		for AladinoExca[i] != -1 || AladinoExca[i+1] != state {
+		for AladinoExca[i] != -1 || int(AladinoExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; AladinoExca[i] >= 0; i += 2 {
-			tok := AladinoExca[i]
+			tok := int(AladinoExca[i])
 			if tok < TOKSTART || AladinoExca[i+1] == 0 {
 				continue
 			}
@@ -273,30 +462,30 @@ func Aladinolex1(lex AladinoLexer, lval *AladinoSymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = AladinoTok1[0]
+		token = int(AladinoTok1[0])
 		goto out
 	}
 	if char < len(AladinoTok1) {
-		token = AladinoTok1[char]
+		token = int(AladinoTok1[char])
 		goto out
 	}
 	if char >= AladinoPrivate {
 		if char < AladinoPrivate+len(AladinoTok2) {
-			token = AladinoTok2[char-AladinoPrivate]
+			token = int(AladinoTok2[char-AladinoPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(AladinoTok3); i += 2 {
-		token = AladinoTok3[i+0]
+		token = int(AladinoTok3[i+0])
 		if token == char {
-			token = AladinoTok3[i+1]
+			token = int(AladinoTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = AladinoTok2[1] /* unknown char */
+		token = int(AladinoTok2[1]) /* unknown char */
 	}
 	if AladinoDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", AladinoTokname(token), uint(char))
@@ -351,7 +540,7 @@ Aladinostack:
 	AladinoS[Aladinop].yys = Aladinostate
 
 Aladinonewstate:
-	Aladinon = AladinoPact[Aladinostate]
+	Aladinon = int(AladinoPact[Aladinostate])
 	if Aladinon <= AladinoFlag {
 		goto Aladinodefault /* simple state */
 	}
@@ -362,8 +551,8 @@ Aladinonewstate:
 	if Aladinon < 0 || Aladinon >= AladinoLast {
 		goto Aladinodefault
 	}
-	Aladinon = AladinoAct[Aladinon]
-	if AladinoChk[Aladinon] == Aladinotoken { /* valid shift */
+	Aladinon = int(AladinoAct[Aladinon])
+	if int(AladinoChk[Aladinon]) == Aladinotoken { /* valid shift */
 		Aladinorcvr.char = -1
 		Aladinotoken = -1
 		AladinoVAL = Aladinorcvr.lval
@@ -376,7 +565,7 @@ Aladinonewstate:
 
 Aladinodefault:
 	/* default state action */
-	Aladinon = AladinoDef[Aladinostate]
+	Aladinon = int(AladinoDef[Aladinostate])
 	if Aladinon == -2 {
 		if Aladinorcvr.char < 0 {
 			Aladinorcvr.char, Aladinotoken = Aladinolex1(Aladinolex, &Aladinorcvr.lval)
@@ -385,18 +574,18 @@ Aladinodefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if AladinoExca[xi+0] == -1 && AladinoExca[xi+1] == Aladinostate {
+			if AladinoExca[xi+0] == -1 && int(AladinoExca[xi+1]) == Aladinostate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			Aladinon = AladinoExca[xi+0]
+			Aladinon = int(AladinoExca[xi+0])
 			if Aladinon < 0 || Aladinon == Aladinotoken {
 				break
 			}
 		}
-		Aladinon = AladinoExca[xi+1]
+		Aladinon = int(AladinoExca[xi+1])
 		if Aladinon < 0 {
 			goto ret0
 		}
@@ -418,10 +607,10 @@ Aladinodefault:
 
 			/* find a state where "error" is a legal shift action */
 			for Aladinop >= 0 {
-				Aladinon = AladinoPact[AladinoS[Aladinop].yys] + AladinoErrCode
+				Aladinon = int(AladinoPact[AladinoS[Aladinop].yys]) + AladinoErrCode
 				if Aladinon >= 0 && Aladinon < AladinoLast {
-					Aladinostate = AladinoAct[Aladinon] /* simulate a shift of "error" */
-					if AladinoChk[Aladinostate] == AladinoErrCode {
+					Aladinostate = int(AladinoAct[Aladinon]) /* simulate a shift of "error" */
+					if int(AladinoChk[Aladinostate]) == AladinoErrCode {
 						goto Aladinostack
 					}
 				}
@@ -457,7 +646,7 @@ Aladinodefault:
 	Aladinopt := Aladinop
 	_ = Aladinopt // guard against "declared and not used"
 
-	Aladinop -= AladinoR2[Aladinon]
+	Aladinop -= int(AladinoR2[Aladinon])
 	// Aladinop is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if Aladinop+1 >= len(AladinoS) {
@@ -468,16 +657,16 @@ Aladinodefault:
 	AladinoVAL = AladinoS[Aladinop+1]
 
 	/* consult goto table to find next state */
-	Aladinon = AladinoR1[Aladinon]
-	Aladinog := AladinoPgo[Aladinon]
+	Aladinon = int(AladinoR1[Aladinon])
+	Aladinog := int(AladinoPgo[Aladinon])
 	Aladinoj := Aladinog + AladinoS[Aladinop].yys + 1
 
 	if Aladinoj >= AladinoLast {
-		Aladinostate = AladinoAct[Aladinog]
+		Aladinostate = int(AladinoAct[Aladinog])
 	} else {
-		Aladinostate = AladinoAct[Aladinoj]
-		if AladinoChk[Aladinostate] != -Aladinon {
-			Aladinostate = AladinoAct[Aladinog]
+		Aladinostate = int(AladinoAct[Aladinoj])
+		if int(AladinoChk[Aladinostate]) != -Aladinon {
+			Aladinostate = int(AladinoAct[Aladinog])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -491,94 +680,257 @@ Aladinodefault:
 	case 2:
 		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildNotOp(AladinoDollar[2].ast)
+			AladinoVAL.ast = withPos(BuildNotOp(AladinoDollar[2].ast), AladinoDollar[1].pos)
 		}
 	case 3:
-		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildAndOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			AladinoVAL.ast = withPos(BuildNegOp(AladinoDollar[2].ast), AladinoDollar[1].pos)
 		}
 	case 4:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildOrOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			AladinoVAL.ast = withPos(BuildAndOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 5:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildEqOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			AladinoVAL.ast = withPos(BuildOrOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 6:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildNeqOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			AladinoVAL.ast = withPos(BuildEqOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 7:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildCmpOp(AladinoDollar[1].ast, AladinoDollar[2].str, AladinoDollar[3].ast)
+			AladinoVAL.ast = withPos(BuildNeqOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 8:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = AladinoDollar[2].ast
+			AladinoVAL.ast = withPos(BuildCmpOp(AladinoDollar[1].ast, AladinoDollar[2].str, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 9:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildTimeConst(AladinoDollar[1].str)
+			AladinoVAL.ast = withPos(BuildMatchOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 10:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildRelativeTimeConst(AladinoDollar[1].str)
+			AladinoVAL.ast = withPos(BuildNotMatchOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 11:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildIntConst(AladinoDollar[1].int)
+			AladinoVAL.ast = withPos(BuildPlusOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 12:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildStringConst(AladinoDollar[1].str)
+			AladinoVAL.ast = withPos(BuildMinusOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 13:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildArray(AladinoDollar[2].astList)
+			AladinoVAL.ast = withPos(BuildMultOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 14:
-		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildVariable(AladinoDollar[2].str)
+			AladinoVAL.ast = withPos(BuildDivOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 15:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildBoolConst(true)
+			AladinoVAL.ast = withPos(BuildModOp(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 16:
-		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildBoolConst(false)
+			AladinoVAL.ast = withPos(BuildFieldAccess(AladinoDollar[1].ast, AladinoDollar[3].str), AladinoDollar[2].pos)
 		}
 	case 17:
-		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
+		AladinoDollar = AladinoS[Aladinopt-4 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildFunctionCall(BuildVariable(AladinoDollar[2].str), AladinoDollar[4].astList)
+			AladinoVAL.ast = withPos(BuildIndexAccess(AladinoDollar[1].ast, AladinoDollar[3].ast), AladinoDollar[2].pos)
 		}
 	case 18:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
-			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+			AladinoVAL.ast = AladinoDollar[2].ast
 		}
 	case 19:
+		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildLambda(AladinoDollar[2].astList, AladinoDollar[4].ast), AladinoDollar[1].pos)
+		}
+	case 20:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildTimeConst(AladinoDollar[1].str), AladinoDollar[1].pos)
+		}
+	case 21:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildRelativeTimeConst(AladinoDollar[1].str), AladinoDollar[1].pos)
+		}
+	case 22:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildDurationConst(AladinoDollar[1].str), AladinoDollar[1].pos)
+		}
+	case 23:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildIntConst(AladinoDollar[1].int), AladinoDollar[1].pos)
+		}
+	case 24:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildStringConst(AladinoDollar[1].str), AladinoDollar[1].pos)
+		}
+	case 25:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildRegexConst(AladinoDollar[1].str), AladinoDollar[1].pos)
+		}
+	case 26:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildArray(AladinoDollar[2].astList), AladinoDollar[1].pos)
+		}
+	case 27:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildMap(AladinoDollar[2].entryList), AladinoDollar[1].pos)
+		}
+	case 28:
+		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildVariable(AladinoDollar[2].str), AladinoDollar[1].pos)
+		}
+	case 29:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildBoolConst(true), AladinoDollar[1].pos)
+		}
+	case 30:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildBoolConst(false), AladinoDollar[1].pos)
+		}
+	case 31:
+		AladinoDollar = AladinoS[Aladinopt-6 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildConditional(AladinoDollar[2].ast, AladinoDollar[4].ast, AladinoDollar[6].ast), AladinoDollar[1].pos)
+		}
+	case 32:
+		AladinoDollar = AladinoS[Aladinopt-7 : Aladinopt+1]
+		{
+			variable := withPos(BuildVariable(AladinoDollar[3].str), AladinoDollar[2].pos).(*Variable)
+			AladinoVAL.ast = withPos(BuildLet(variable, AladinoDollar[5].ast, AladinoDollar[7].ast), AladinoDollar[1].pos)
+		}
+	case 33:
+		AladinoDollar = AladinoS[Aladinopt-6 : Aladinopt+1]
+		{
+			AladinoVAL.ast = withPos(BuildTry(AladinoDollar[3].ast, AladinoDollar[5].ast), AladinoDollar[1].pos)
+		}
+	case 34:
+		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
+		{
+			name := withPos(BuildVariable(AladinoDollar[2].str), AladinoDollar[1].pos).(*Variable)
+			AladinoVAL.ast = withPos(buildFunctionCall(Aladinolex, name, AladinoDollar[4].argList), AladinoDollar[1].pos)
+		}
+	case 35:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+		}
+	case 36:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
 		}
-	case 20:
+	case 37:
+		AladinoDollar = AladinoS[Aladinopt-4 : Aladinopt+1]
+		{
+			param := withPos(BuildVariable(AladinoDollar[2].str), AladinoDollar[1].pos)
+			AladinoVAL.ast = withPos(BuildTypedExpr(param, AladinoDollar[4].typ), AladinoDollar[1].pos)
+		}
+	case 38:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.typ = buildType(Aladinolex, AladinoDollar[1].str, AladinoDollar[1].pos)
+		}
+	case 39:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.typ = BuildArrayOfType(AladinoDollar[3].typ)
+		}
+	case 40:
+		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
+		{
+			AladinoVAL.typ = buildMapType(Aladinolex, AladinoDollar[1].str, AladinoDollar[3].str, AladinoDollar[5].typ, AladinoDollar[1].pos)
+		}
+	case 41:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.entryList = append([]MapEntry{AladinoDollar[1].entry}, AladinoDollar[3].entryList...)
+		}
+	case 42:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.entryList = []MapEntry{AladinoDollar[1].entry}
+		}
+	case 43:
+		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
+		{
+			AladinoVAL.entryList = []MapEntry{}
+		}
+	case 44:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.entry = BuildMapEntry(AladinoDollar[1].ast, AladinoDollar[3].ast)
+		}
+	case 45:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.argList = append([]argument{AladinoDollar[1].arg}, AladinoDollar[3].argList...)
+		}
+	case 46:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.argList = []argument{AladinoDollar[1].arg}
+		}
+	case 47:
+		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
+		{
+			AladinoVAL.argList = []argument{}
+		}
+	case 48:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.arg = argument{expr: AladinoDollar[1].ast}
+		}
+	case 49:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.arg = argument{name: AladinoDollar[1].str, expr: AladinoDollar[3].ast, pos: AladinoDollar[1].pos}
+		}
+	case 50:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+		}
+	case 51:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
+		}
+	case 52:
 		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
 		{
 			AladinoVAL.astList = []Expr{}
@@ -10,6 +10,75 @@ var base int
 func setAST(l AladinoLexer, root Expr) {
     l.(*AladinoLex).ast = root
 }
+
+// buildType returns the type with the given name.
+// Unknown types are reported as parse errors.
+func buildType(l AladinoLexer, name string, pos Position) Type {
+    ty, err := parseType(name)
+    if err != nil {
+        lex := l.(*AladinoLex)
+        if lex.err == nil {
+            lex.err = parseError(pos, lex.source, "%v", err)
+        }
+    }
+
+    return ty
+}
+
+// buildMapType returns the type map[key]value.
+// Maps can only have string keys.
+func buildMapType(l AladinoLexer, name, key string, value Type, pos Position) Type {
+    if name != "map" || key != "String" {
+        lex := l.(*AladinoLex)
+        if lex.err == nil {
+            lex.err = parseError(pos, lex.source, "unknown type \"%v[%v]\"", name, key)
+        }
+    }
+
+    return BuildMapType(value)
+}
+
+// argument is an argument of a function call, which may be given by name - e.g. total: 2.
+type argument struct {
+    name string
+    expr Expr
+    pos  Position
+}
+
+// buildFunctionCall returns the call to the function with the given arguments.
+// Named arguments must come after the positional ones and can only be given once.
+func buildFunctionCall(l AladinoLexer, name *Variable, args []argument) *FunctionCall {
+    lex := l.(*AladinoLex)
+
+    exprs := make([]Expr, len(args))
+    argNames := make([]string, len(args))
+    hasNamedArgs := false
+    for i, arg := range args {
+        exprs[i] = arg.expr
+        argNames[i] = arg.name
+
+        if arg.name == "" {
+            if hasNamedArgs && lex.err == nil {
+                lex.err = parseError(arg.expr.Pos(), lex.source, "positional argument after named arguments")
+            }
+            continue
+        }
+
+        for _, argName := range argNames[:i] {
+            if argName == arg.name && lex.err == nil {
+                lex.err = parseError(arg.pos, lex.source, "argument %v is given more than once", arg.name)
+            }
+        }
+
+        hasNamedArgs = true
+    }
+
+    if !hasNamedArgs {
+        return BuildFunctionCall(name, exprs)
+    }
+
+    return BuildFunctionCallWithArgNames(name, exprs, argNames)
+}
 %}
 
 // fields inside this union end up as the fields in a structure known
@@ -19,24 +88,42 @@ func setAST(l AladinoLexer, root Expr) {
     int int
     ast Expr
     astList []Expr
+    entry MapEntry
+    entryList []MapEntry
+    arg argument
+    argList []argument
+    typ Type
     bool bool
+    pos Position
 }
 
 // any non-terminal which returns a value needs a type, which is
 // really a field name in the above union struct
 %type <ast> expr
-%type <astList> expr_list
+%type <astList> expr_list lambda_params
+%type <ast> lambda_param
+%type <typ> type
+%type <entry> map_entry
+%type <entryList> map_entries
+%type <arg> arg
+%type <argList> arg_list
 
 // same for terminals
-%token <str> TIMESTAMP RELATIVETIMESTAMP IDENTIFIER STRINGLITERAL TK_CMPOP 
+%token <str> TIMESTAMP RELATIVETIMESTAMP DURATION IDENTIFIER STRINGLITERAL REGEXLITERAL TK_CMPOP 
 %token <int> NUMBER
 %token <bool> TRUE
 %token <bool> FALSE
+%token TK_ARROW TK_MATCH TK_NOT_MATCH TK_IF TK_THEN TK_LET TK_TRY
 
+// The else branch of a conditional and the body of a let-binding extend as far as possible.
+%right TK_ELSE TK_IN
 %left TK_OR
 %left TK_AND
-%left TK_EQ TK_NEQ TK_CMPOP
+%left TK_EQ TK_NEQ TK_CMPOP TK_MATCH TK_NOT_MATCH
+%left TK_PLUS TK_MINUS
+%left TK_MULT TK_DIV TK_MOD
 %left TK_NOT
+%left '.' '['
 
 %%
 
@@ -44,24 +131,90 @@ prog :
       expr { setAST(Aladinolex, $1) }
 ;
 
+// The position of a unary or binary operation, or of a field or index access, is the position of its operator.
+// Every other expression is positioned at its first token.
 expr :
-      TK_NOT expr        { $$ = BuildNotOp($2) }
-    | expr TK_AND expr   { $$ = BuildAndOp($1, $3) }
-    | expr TK_OR expr    { $$ = BuildOrOp($1, $3) }
-    | expr TK_EQ expr    { $$ = BuildEqOp($1, $3) }
-    | expr TK_NEQ expr   { $$ = BuildNeqOp($1, $3) }
-    | expr TK_CMPOP expr { $$ = BuildCmpOp($1, $2, $3) }
+      TK_NOT expr        { $$ = withPos(BuildNotOp($2), $<pos>1) }
+    | TK_MINUS expr %prec TK_NOT { $$ = withPos(BuildNegOp($2), $<pos>1) }
+    | expr TK_AND expr   { $$ = withPos(BuildAndOp($1, $3), $<pos>2) }
+    | expr TK_OR expr    { $$ = withPos(BuildOrOp($1, $3), $<pos>2) }
+    | expr TK_EQ expr    { $$ = withPos(BuildEqOp($1, $3), $<pos>2) }
+    | expr TK_NEQ expr   { $$ = withPos(BuildNeqOp($1, $3), $<pos>2) }
+    | expr TK_CMPOP expr { $$ = withPos(BuildCmpOp($1, $2, $3), $<pos>2) }
+    | expr TK_MATCH expr { $$ = withPos(BuildMatchOp($1, $3), $<pos>2) }
+    | expr TK_NOT_MATCH expr { $$ = withPos(BuildNotMatchOp($1, $3), $<pos>2) }
+    | expr TK_PLUS expr  { $$ = withPos(BuildPlusOp($1, $3), $<pos>2) }
+    | expr TK_MINUS expr { $$ = withPos(BuildMinusOp($1, $3), $<pos>2) }
+    | expr TK_MULT expr  { $$ = withPos(BuildMultOp($1, $3), $<pos>2) }
+    | expr TK_DIV expr   { $$ = withPos(BuildDivOp($1, $3), $<pos>2) }
+    | expr TK_MOD expr   { $$ = withPos(BuildModOp($1, $3), $<pos>2) }
+    | expr '.' IDENTIFIER { $$ = withPos(BuildFieldAccess($1, $3), $<pos>2) }
+    | expr '[' expr ']'  { $$ = withPos(BuildIndexAccess($1, $3), $<pos>2) }
     | '(' expr ')'       { $$ = $2 }
-    | TIMESTAMP          { $$ = BuildTimeConst($1) }
-    | RELATIVETIMESTAMP  { $$ = BuildRelativeTimeConst($1) }
-    | NUMBER             { $$ = BuildIntConst($1) }
-    | STRINGLITERAL      { $$ = BuildStringConst($1) }
-    | '[' expr_list ']'  { $$ = BuildArray($2) }
-    | '$' IDENTIFIER     { $$ = BuildVariable($2) }
-    | TRUE               { $$ = BuildBoolConst(true) }
-    | FALSE              { $$ = BuildBoolConst(false) }
-    | '$' IDENTIFIER '(' expr_list ')' 
-        { $$ = BuildFunctionCall(BuildVariable($2), $4) }
+    | '(' lambda_params TK_ARROW expr ')' { $$ = withPos(BuildLambda($2, $4), $<pos>1) }
+    | TIMESTAMP          { $$ = withPos(BuildTimeConst($1), $<pos>1) }
+    | RELATIVETIMESTAMP  { $$ = withPos(BuildRelativeTimeConst($1), $<pos>1) }
+    | DURATION           { $$ = withPos(BuildDurationConst($1), $<pos>1) }
+    | NUMBER             { $$ = withPos(BuildIntConst($1), $<pos>1) }
+    | STRINGLITERAL      { $$ = withPos(BuildStringConst($1), $<pos>1) }
+    | REGEXLITERAL       { $$ = withPos(BuildRegexConst($1), $<pos>1) }
+    | '[' expr_list ']'  { $$ = withPos(BuildArray($2), $<pos>1) }
+    | '{' map_entries '}' { $$ = withPos(BuildMap($2), $<pos>1) }
+    | '$' IDENTIFIER     { $$ = withPos(BuildVariable($2), $<pos>1) }
+    | TRUE               { $$ = withPos(BuildBoolConst(true), $<pos>1) }
+    | FALSE              { $$ = withPos(BuildBoolConst(false), $<pos>1) }
+    | TK_IF expr TK_THEN expr TK_ELSE expr { $$ = withPos(BuildConditional($2, $4, $6), $<pos>1) }
+    | TK_LET '$' IDENTIFIER '=' expr TK_IN expr
+        {
+            variable := withPos(BuildVariable($3), $<pos>2).(*Variable)
+            $$ = withPos(BuildLet(variable, $5, $7), $<pos>1)
+        }
+    | TK_TRY '(' expr ',' expr ')' { $$ = withPos(BuildTry($3, $5), $<pos>1) }
+    | '$' IDENTIFIER '(' arg_list ')' 
+        {
+            name := withPos(BuildVariable($2), $<pos>1).(*Variable)
+            $$ = withPos(buildFunctionCall(Aladinolex, name, $4), $<pos>1)
+        }
+;
+
+lambda_params :
+      lambda_param ',' lambda_params { $$ = append([]Expr{$1}, $3...) }
+    | lambda_param                   { $$ = []Expr{$1} }
+;
+
+lambda_param :
+      '$' IDENTIFIER ':' type
+        {
+            param := withPos(BuildVariable($2), $<pos>1)
+            $$ = withPos(BuildTypedExpr(param, $4), $<pos>1)
+        }
+;
+
+type :
+      IDENTIFIER   { $$ = buildType(Aladinolex, $1, $<pos>1) }
+    | '[' ']' type { $$ = BuildArrayOfType($3) }
+    | IDENTIFIER '[' IDENTIFIER ']' type { $$ = buildMapType(Aladinolex, $1, $3, $5, $<pos>1) }
+;
+
+map_entries :
+      map_entry ',' map_entries { $$ = append([]MapEntry{$1}, $3...) }
+    | map_entry                 { $$ = []MapEntry{$1} }
+    |                           { $$ = []MapEntry{} }
+;
+
+map_entry :
+      expr ':' expr { $$ = BuildMapEntry($1, $3) }
+;
+
+arg_list :
+      arg ',' arg_list { $$ = append([]argument{$1}, $3...) }
+    | arg              { $$ = []argument{$1} }
+    |                  { $$ = []argument{} }
+;
+
+arg :
+      expr                { $$ = argument{expr: $1} }
+    | IDENTIFIER ':' expr { $$ = argument{name: $1, expr: $3, pos: $<pos>1} }
 ;
 
 expr_list :
@@ -0,0 +1,304 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// builtInPrefix matches the name of a built-in being written at the end of the input, e.g. $hasFile.
+	builtInPrefix = regexp.MustCompile(`\$[a-zA-Z0-9]*$`)
+	// namePrefix matches the name of a rule or a group being written at the end of the input, e.g. $rule("is-s.
+	namePrefix = regexp.MustCompile(`\$(rule|group)\(\s*"([^"]*)$`)
+)
+
+// Inspect parses, type checks and evaluates an expression of any type.
+// A call to an action is only executed when the environment is not in dry-run mode.
+// Since actions do not return anything, both the value and the type of an action are nil.
+func Inspect(env Env, source string) (Value, Type, error) {
+	expr, err := Parse(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if call, ok := expr.(*FunctionCall); ok {
+		if _, isAction := env.GetBuiltIns().Actions[call.name.ident]; isAction {
+			statement, err := compileStatement(env, source)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !env.GetDryRun() {
+				err = statement.exec(env)
+			}
+
+			return nil, nil, err
+		}
+	}
+
+	exprType, err := TypeInference(env, expr)
+	if err != nil {
+		return nil, nil, withSource(err, source)
+	}
+
+	value, err := Eval(env, expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return value, exprType, nil
+}
+
+// Complete returns the words that complete the last word of the input, sorted,
+// and the position of the input where that word starts.
+// The names of the built-ins are completed after a $ and the names of the rules
+// and groups are completed inside the string argument of $rule and $group.
+func Complete(env Env, input string) (int, []string) {
+	if match := namePrefix.FindStringSubmatchIndex(input); match != nil {
+		kind := input[match[2]:match[3]]
+		prefix := input[match[4]:match[5]]
+
+		names := groupNames(env)
+		if kind == "rule" {
+			names = ruleNames(env)
+		}
+
+		return match[4], completeWith(prefix, names, "", `"`)
+	}
+
+	if match := builtInPrefix.FindStringIndex(input); match != nil {
+		prefix := input[match[0]+1:]
+
+		builtIns := env.GetBuiltIns()
+		names := make([]string, 0, len(builtIns.Functions)+len(builtIns.Actions))
+		for name := range builtIns.Functions {
+			names = append(names, name)
+		}
+		for name := range builtIns.Actions {
+			names = append(names, name)
+		}
+
+		return match[0], completeWith(prefix, names, "$", "")
+	}
+
+	return len(input), []string{}
+}
+
+func completeWith(prefix string, names []string, start, end string) []string {
+	words := make([]string, 0)
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			words = append(words, start+name+end)
+		}
+	}
+
+	sort.Strings(words)
+
+	return words
+}
+
+// ruleNames returns the names of the rules processed by the interpreter.
+func ruleNames(env Env) []string {
+	internalRulePrefix := BuildInternalRuleName("")
+
+	names := make([]string, 0)
+	for name := range env.GetRegisterMap() {
+		if strings.HasPrefix(name, internalRulePrefix) {
+			names = append(names, strings.TrimPrefix(name, internalRulePrefix))
+		}
+	}
+
+	return names
+}
+
+// groupNames returns the names of the groups processed by the interpreter.
+// Unlike rules and labels, groups are registered by their name.
+func groupNames(env Env) []string {
+	names := make([]string, 0)
+	for name := range env.GetRegisterMap() {
+		if !strings.HasPrefix(name, "@") {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// FormatType returns the name of a type as it is written in the reviewpad file, e.g. []String or Optional[Int],
+// so that the repl can show the type of the evaluated expressions.
+// Function types are written as (Int, String) => Bool.
+func FormatType(ty Type) string {
+	switch t := ty.(type) {
+	case *BoolType:
+		return "Bool"
+	case *IntType:
+		return "Int"
+	case *StringType:
+		return "String"
+	case *TimestampType:
+		return "Timestamp"
+	case *DurationType:
+		return "Duration"
+	case *RegexType:
+		return "Regex"
+	case *TypeVariable:
+		return t.name
+	case *ArrayOfType:
+		return "[]" + FormatType(t.elemType)
+	case *ArrayType:
+		if len(t.elemsType) == 0 {
+			return "[]"
+		}
+		// The elements of array literals usually have the same type.
+		if arrayOfType, ok := make(substitution).asArrayOf(t).(*ArrayOfType); ok {
+			return FormatType(arrayOfType)
+		}
+		return "[" + formatTypes(t.elemsType) + "]"
+	case *MapType:
+		return "map[String]" + FormatType(t.valueType)
+	case *OptionalType:
+		return "Optional[" + FormatType(t.elemType) + "]"
+	case *RecordType:
+		return formatRecordType(t)
+	case *FunctionType:
+		return formatFunctionType(t)
+	}
+
+	return fmt.Sprintf("%v", ty)
+}
+
+func formatTypes(types []Type) string {
+	names := make([]string, len(types))
+	for i, ty := range types {
+		names[i] = FormatType(ty)
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatRecordType(rTy *RecordType) string {
+	namedRecords := map[string]*RecordType{
+		"Commit": BuildCommitType(),
+		"Review": BuildReviewType(),
+		"File":   BuildFileType(),
+	}
+
+	for name, namedRecord := range namedRecords {
+		if rTy.equals(namedRecord) {
+			return name
+		}
+	}
+
+	names := make([]string, 0, len(rTy.fields))
+	for name := range rTy.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]string, len(names))
+	for i, name := range names {
+		fields[i] = fmt.Sprintf("%v: %v", name, FormatType(rTy.fields[name]))
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// formatFunctionType writes the names of the parameters when they are known,
+// e.g. (reviewers: []String, total: Int = 99) => ().
+func formatFunctionType(fTy *FunctionType) string {
+	firstDefault := len(fTy.paramTypes) - len(fTy.defaults)
+
+	params := make([]string, 0, len(fTy.paramTypes)+1)
+	for i, paramType := range fTy.paramTypes {
+		param := FormatType(paramType)
+		if name := fTy.paramName(i); name != "" {
+			param = name + ": " + param
+		}
+
+		if i >= firstDefault {
+			param = fmt.Sprintf("%v = %v", param, FormatValue(fTy.defaults[i-firstDefault]))
+		}
+
+		params = append(params, param)
+	}
+
+	if fTy.variadicType != nil {
+		params = append(params, "..."+FormatType(fTy.variadicType))
+	}
+
+	// Actions do not return a value.
+	returnType := "()"
+	if fTy.returnType != nil {
+		returnType = FormatType(fTy.returnType)
+	}
+
+	return fmt.Sprintf("(%v) => %v", strings.Join(params, ", "), returnType)
+}
+
+// FormatValue returns a value written as an Aladino expression, whenever possible.
+func FormatValue(val Value) string {
+	switch v := val.(type) {
+	case *IntValue:
+		return fmt.Sprintf("%v", v.Val)
+	case *BoolValue:
+		return fmt.Sprintf("%v", v.Val)
+	case *StringValue:
+		return fmt.Sprintf("%q", v.Val)
+	case *TimeValue:
+		return time.Unix(int64(v.Val), 0).UTC().Format(time.RFC3339)
+	case *DurationValue:
+		// durations are written as duration literals, e.g. 2 days, and negative ones are negated
+		if v.Val < 0 {
+			return "-" + formatDuration(-v.Val)
+		}
+		return formatDuration(v.Val)
+	case *RegexValue:
+		return fmt.Sprintf("r%q", v.Val.String())
+	case *ArrayValue:
+		elems := make([]string, len(v.Vals))
+		for i, elem := range v.Vals {
+			elems[i] = FormatValue(elem)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case *MapValue:
+		return formatEntries(v.Vals, true)
+	case *RecordValue:
+		return formatEntries(v.Vals, false)
+	case *OptionalValue:
+		if !v.IsSet() {
+			return "none"
+		}
+		return "some(" + FormatValue(v.Val) + ")"
+	case *FunctionValue:
+		return "<function>"
+	}
+
+	return fmt.Sprintf("%v", val)
+}
+
+// formatEntries writes the entries sorted by key, so that the same value is always written the same way.
+// The keys of maps are strings while the keys of records are field names.
+func formatEntries(vals map[string]Value, quoteKeys bool) string {
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, len(keys))
+	for i, key := range keys {
+		formattedKey := key
+		if quoteKeys {
+			formattedKey = fmt.Sprintf("%q", key)
+		}
+		entries[i] = fmt.Sprintf("%v: %v", formattedKey, FormatValue(vals[key]))
+	}
+
+	return "{" + strings.Join(entries, ", ") + "}"
+}
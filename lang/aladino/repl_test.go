@@ -0,0 +1,256 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspect(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		input     string
+		wantValue aladino.Value
+		wantType  aladino.Type
+		wantErr   string
+	}{
+		"when expression is a condition": {
+			input:     `$zeroConst() == 0`,
+			wantValue: aladino.BuildTrueValue(),
+			wantType:  aladino.BuildBoolType(),
+		},
+		"when expression is not a condition": {
+			input:     `[$returnStr("a"), "b"]`,
+			wantValue: aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildStringValue("b")}),
+			wantType:  aladino.BuildArrayType([]aladino.Type{aladino.BuildStringType(), aladino.BuildStringType()}),
+		},
+		"when expression is an action": {
+			input: `$emptyAction()`,
+		},
+		"when expression is ill-typed": {
+			input:   `$returnStr(1)`,
+			wantErr: "type error at line 1, column 1: type inference failed: mismatch in arg types on returnStr\n    $returnStr(1)\n    ^",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotType, err := aladino.Inspect(mockedEnv, test.input)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestInspect_WhenActionIsCalledInDryRun(t *testing.T) {
+	executed := false
+	builtIns := aladino.MockBuiltIns()
+	builtIns.Actions["emptyAction"].Code = func(e aladino.Env, args []aladino.Value) error {
+		executed = true
+		return nil
+	}
+
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+	mockedEnv.(*aladino.BaseEnv).DryRun = true
+
+	_, _, err := aladino.Inspect(mockedEnv, `$emptyAction()`)
+
+	assert.Nil(t, err)
+	assert.False(t, executed)
+}
+
+func TestComplete(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	registerMap := mockedEnv.GetRegisterMap()
+	registerMap[aladino.BuildInternalRuleName("is-small")] = aladino.BuildStringValue("$size() < 10")
+	registerMap[aladino.BuildInternalRuleName("is-draft")] = aladino.BuildStringValue("$isDraft()")
+	registerMap[aladino.BuildInternalLabelID("small")] = aladino.BuildStringValue("small")
+	registerMap["seniors"] = aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("john")})
+
+	tests := map[string]struct {
+		input     string
+		wantStart int
+		wantWords []string
+	}{
+		"when completing a built-in": {
+			input:     `$zeroConst() > 0 && $e`,
+			wantStart: 20,
+			wantWords: []string{`$emptyAction`, `$emptyFunction`},
+		},
+		"when completing a rule": {
+			input:     `$rule("is-s`,
+			wantStart: 7,
+			wantWords: []string{`is-small"`},
+		},
+		"when completing a group": {
+			input:     `$group("`,
+			wantStart: 8,
+			wantWords: []string{`seniors"`},
+		},
+		"when there is nothing to complete": {
+			input:     `1 + `,
+			wantStart: 4,
+			wantWords: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotStart, gotWords := aladino.Complete(mockedEnv, test.input)
+
+			assert.Equal(t, test.wantStart, gotStart)
+			assert.Equal(t, test.wantWords, gotWords)
+		})
+	}
+}
+
+func TestFormatType(t *testing.T) {
+	tests := map[string]struct {
+		inputType aladino.Type
+		wantName  string
+	}{
+		"when type is basic": {
+			inputType: aladino.BuildTimestampType(),
+			wantName:  "Timestamp",
+		},
+		"when type is an array": {
+			inputType: aladino.BuildArrayOfType(aladino.BuildStringType()),
+			wantName:  "[]String",
+		},
+		"when type is an array literal": {
+			inputType: aladino.BuildArrayType([]aladino.Type{aladino.BuildIntType(), aladino.BuildIntType()}),
+			wantName:  "[]Int",
+		},
+		"when type is a map of optional values": {
+			inputType: aladino.BuildMapType(aladino.BuildOptionalType(aladino.BuildIntType())),
+			wantName:  "map[String]Optional[Int]",
+		},
+		"when type is a named record": {
+			inputType: aladino.BuildArrayOfType(aladino.BuildReviewType()),
+			wantName:  "[]Review",
+		},
+		"when type is a record": {
+			inputType: aladino.BuildRecordType(map[string]aladino.Type{"size": aladino.BuildIntType(), "name": aladino.BuildStringType()}),
+			wantName:  "{name: String, size: Int}",
+		},
+		"when type is a generic function": {
+			inputType: aladino.BuildFunctionType(
+				[]aladino.Type{
+					aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+					aladino.BuildFunctionType([]aladino.Type{aladino.BuildTypeVariable("a")}, aladino.BuildBoolType()),
+				},
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+			),
+			wantName: "([]a, (a) => Bool) => []a",
+		},
+		"when type is an action with named and optional parameters": {
+			inputType: aladino.BuildFunctionTypeWithDefaults(
+				[]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildIntType()},
+				[]aladino.Value{aladino.BuildIntValue(99)},
+				nil,
+			).WithParamNames("reviewers", "total"),
+			wantName: "(reviewers: []String, total: Int = 99) => ()",
+		},
+		"when type is a variadic function": {
+			inputType: aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildTypeVariable("a"), aladino.BuildStringType()),
+			wantName:  "(String, ...a) => String",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantName, aladino.FormatType(test.inputType))
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := map[string]struct {
+		inputValue aladino.Value
+		wantText   string
+	}{
+		"when value is a string": {
+			inputValue: aladino.BuildStringValue(`say "hi"`),
+			wantText:   `"say \"hi\""`,
+		},
+		"when value is a timestamp": {
+			inputValue: aladino.BuildTimeValue(1649196110),
+			wantText:   "2022-04-05T22:01:50Z",
+		},
+		"when value is a duration": {
+			inputValue: aladino.BuildDurationValue(2 * 24 * 60 * 60),
+			wantText:   "2 days",
+		},
+		"when value is a duration without a larger unit": {
+			inputValue: aladino.BuildDurationValue(49 * 60 * 60),
+			wantText:   "49 hours",
+		},
+		"when value is a negative duration": {
+			inputValue: aladino.BuildDurationValue(-90),
+			wantText:   "-90 seconds",
+		},
+		"when value is a regex": {
+			inputValue: aladino.BuildRegexValue(regexp.MustCompile(`^docs/`)),
+			wantText:   `r"^docs/"`,
+		},
+		"when value is an array": {
+			inputValue: aladino.BuildArrayValue([]aladino.Value{aladino.BuildIntValue(1), aladino.BuildTrueValue()}),
+			wantText:   "[1, true]",
+		},
+		"when value is a map": {
+			inputValue: aladino.BuildMapValue(map[string]aladino.Value{"small": aladino.BuildIntValue(10), "large": aladino.BuildIntValue(100)}),
+			wantText:   `{"large": 100, "small": 10}`,
+		},
+		"when value is a record": {
+			inputValue: aladino.BuildRecordValue(map[string]aladino.Value{"filename": aladino.BuildStringValue("go.mod")}),
+			wantText:   `{filename: "go.mod"}`,
+		},
+		"when value is absent": {
+			inputValue: aladino.BuildNoneValue(),
+			wantText:   "none",
+		},
+		"when value is present": {
+			inputValue: aladino.BuildSomeValue(aladino.BuildStringValue("v1.0")),
+			wantText:   `some("v1.0")`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantText, aladino.FormatValue(test.inputValue))
+		})
+	}
+}
+
+func TestFormatValue_WhenDurationIsParsedBack(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	for _, duration := range []int{49 * 60 * 60, 2 * 7 * 24 * 60 * 60, -90} {
+		wantVal := aladino.BuildDurationValue(duration)
+
+		expr, err := aladino.Parse(aladino.FormatValue(wantVal))
+		if err != nil {
+			assert.FailNow(t, "parse failed", err)
+		}
+
+		gotVal, err := expr.Eval(mockedEnv)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wantVal, gotVal)
+	}
+}
@@ -16,7 +16,14 @@ import (
 )
 
 type Report struct {
-	Actions []string
+	Actions      []string
+	Explanations []Explanation
+}
+
+// Explanation is the trace of the evaluation of the rules of a workflow.
+type Explanation struct {
+	Workflow string
+	Trace    string
 }
 
 const ReviewpadReportCommentAnnotation = "<!--@annotation-reviewpad-report-->"
@@ -79,6 +86,7 @@ func buildReport(mode string, safeMode bool, reportComments map[Severity][]strin
 	if mode == engine.VERBOSE_MODE || safeMode {
 		sb.WriteString(BuildVerboseReport(report))
 	}
+	sb.WriteString(BuildExplanationsReport(report))
 
 	return sb.String()
 }
@@ -103,6 +111,27 @@ func BuildVerboseReport(report *Report) string {
 	return sb.String()
 }
 
+// BuildExplanationsReport builds a collapsible section with the evaluation of the rules of every workflow.
+func BuildExplanationsReport(report *Report) string {
+	if report == nil || len(report.Explanations) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("<details>\n<summary>:mag: <b>Why</b></summary>\n\n")
+
+	for _, explanation := range report.Explanations {
+		sb.WriteString(fmt.Sprintf("**%v**\n", explanation.Workflow))
+		sb.WriteString("```\n")
+		sb.WriteString(explanation.Trace)
+		sb.WriteString("```\n")
+	}
+
+	sb.WriteString("</details>\n")
+	return sb.String()
+}
+
 func DeleteReportComment(env Env, commentId int64) error {
 	pullRequest := env.GetPullRequest()
 	owner := gh.GetPullRequestBaseOwnerName(pullRequest)
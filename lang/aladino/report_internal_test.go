@@ -112,6 +112,28 @@ func TestBuildVerboseReport(t *testing.T) {
 	assert.Equal(t, wantReport, gotReport)
 }
 
+func TestBuildExplanationsReport_WhenThereAreNoExplanations(t *testing.T) {
+	report := &Report{Actions: []string{"$addLabel(\"test\")"}}
+
+	gotReport := BuildExplanationsReport(report)
+
+	assert.Equal(t, "", gotReport)
+}
+
+func TestBuildExplanationsReport(t *testing.T) {
+	report := &Report{
+		Explanations: []Explanation{
+			{Workflow: "add-label", Trace: "$size() > 10 = false [0s]\n"},
+		},
+	}
+
+	wantReport := "<details>\n<summary>:mag: <b>Why</b></summary>\n\n**add-label**\n```\n$size() > 10 = false [0s]\n```\n</details>\n"
+
+	gotReport := BuildExplanationsReport(report)
+
+	assert.Equal(t, wantReport, gotReport)
+}
+
 func TestDeleteReportComment_WhenCommentCannotBeDeleted(t *testing.T) {
 	failMessage := "DeleteCommentRequestFailed"
 	mockedEnv := MockDefaultEnv(
@@ -0,0 +1,192 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxTracedValueLength is the length above which the values are shortened in the rendered trace.
+const maxTracedValueLength = 80
+
+// TraceNode is the evaluation of an expression, made of the evaluations of its sub-expressions.
+type TraceNode struct {
+	Expr     Expr
+	Value    Value
+	Err      error
+	Duration time.Duration
+	// Call is the call to a built-in made to evaluate the expression, if any.
+	Call     *TraceCall
+	Children []*TraceNode
+}
+
+// TraceCall is a call to a built-in function, with its arguments and its result.
+// The arguments include the default values of the arguments that were left out.
+type TraceCall struct {
+	Name   string
+	Args   []Value
+	Cached bool
+}
+
+// Tracer records the evaluation of expressions.
+// Constants are not recorded since their values are the constants themselves.
+type Tracer struct {
+	// Roots are the evaluations of the expressions that were evaluated on their own,
+	// such as the specs of the rules, in the order they were evaluated.
+	Roots []*TraceNode
+	stack []*TraceNode
+}
+
+func NewTracer() *Tracer {
+	return &Tracer{
+		Roots: make([]*TraceNode, 0),
+		stack: make([]*TraceNode, 0),
+	}
+}
+
+func (t *Tracer) enter(expr Expr) *TraceNode {
+	node := &TraceNode{Expr: expr, Children: make([]*TraceNode, 0)}
+
+	if len(t.stack) == 0 {
+		t.Roots = append(t.Roots, node)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, node)
+	}
+
+	t.stack = append(t.stack, node)
+
+	return node
+}
+
+func (t *Tracer) exit(node *TraceNode, value Value, err error, duration time.Duration) {
+	node.Value = value
+	node.Err = err
+	node.Duration = duration
+
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+// recordCall records the call to a built-in in the expression being evaluated.
+func (t *Tracer) recordCall(name string, args []Value, cached bool) {
+	if len(t.stack) == 0 {
+		return
+	}
+
+	t.stack[len(t.stack)-1].Call = &TraceCall{Name: name, Args: args, Cached: cached}
+}
+
+// Last returns the evaluation of the last expression evaluated on its own, or nil if there is none.
+func (t *Tracer) Last() *TraceNode {
+	if len(t.Roots) == 0 {
+		return nil
+	}
+
+	return t.Roots[len(t.Roots)-1]
+}
+
+// tracedEnv is an environment that records the evaluation of the expressions.
+type tracedEnv struct {
+	Env
+	tracer *Tracer
+}
+
+func (e *tracedEnv) GetTracer() *Tracer {
+	return e.tracer
+}
+
+// Explain evaluates a boolean expression and returns how it was evaluated.
+// The evaluation is returned even when it fails, so that the failing sub-expression can be found.
+func Explain(env Env, expr string) (bool, *TraceNode, error) {
+	exprAST, err := compileCondition(env, expr)
+	if err != nil {
+		return false, nil, err
+	}
+
+	tracer := NewTracer()
+	result, err := EvalCondition(&tracedEnv{Env: env, tracer: tracer}, exprAST)
+
+	return result, tracer.Last(), err
+}
+
+// evalExpr evaluates expr and records its evaluation when the environment has a tracer.
+func evalExpr(e Env, expr Expr) (Value, error) {
+	tracer := e.GetTracer()
+	if tracer == nil || isConstant(expr) {
+		return expr.Eval(e)
+	}
+
+	node := tracer.enter(expr)
+	start := time.Now()
+
+	value, err := expr.Eval(e)
+
+	tracer.exit(node, value, err, time.Since(start))
+
+	return value, err
+}
+
+func isConstant(expr Expr) bool {
+	switch expr.(type) {
+	case *BoolConst, *IntConst, *StringConst, *TimeConst, *DurationConst, *RegexConst:
+		return true
+	}
+
+	return false
+}
+
+// String renders the evaluation as a tree, one expression per line, e.g.
+//
+//	$size() < 10 = false [2ms]
+//	  $size() = 12 [2ms]
+//	    calls size()
+func (node *TraceNode) String() string {
+	var sb strings.Builder
+	node.render(&sb, 0)
+	return sb.String()
+}
+
+func (node *TraceNode) render(sb *strings.Builder, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	result := "error: " + shorten(fmt.Sprintf("%v", node.Err))
+	if node.Err == nil {
+		result = shorten(FormatValue(node.Value))
+	}
+
+	sb.WriteString(fmt.Sprintf("%v%v = %v [%v]\n", indent, FormatExpr(node.Expr), result, node.Duration.Round(time.Millisecond)))
+
+	if node.Call != nil {
+		args := make([]string, len(node.Call.Args))
+		for i, arg := range node.Call.Args {
+			args[i] = shorten(FormatValue(arg))
+		}
+
+		call := fmt.Sprintf("%v  calls %v(%v)", indent, node.Call.Name, strings.Join(args, ", "))
+		if node.Call.Cached {
+			call += " (cached)"
+		}
+
+		sb.WriteString(call + "\n")
+	}
+
+	for _, child := range node.Children {
+		child.render(sb, depth+1)
+	}
+}
+
+// shorten keeps the first line of long values, such as lists of reviews, readable.
+func shorten(text string) string {
+	text = strings.SplitN(text, "\n", 2)[0]
+
+	runes := []rune(text)
+	if len(runes) > maxTracedValueLength {
+		return string(runes[:maxTracedValueLength]) + "..."
+	}
+
+	return text
+}
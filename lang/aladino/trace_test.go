@@ -0,0 +1,121 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	gotResult, gotTrace, err := aladino.Explain(mockedEnv, `$returnStr("a") == "a" && $zeroConst() > 0`)
+
+	assert.Nil(t, err)
+	assert.False(t, gotResult)
+
+	assert.Equal(t, `$returnStr("a") == "a" && $zeroConst() > 0`, aladino.FormatExpr(gotTrace.Expr))
+	assert.Equal(t, aladino.BuildBoolValue(false), gotTrace.Value)
+	assert.Len(t, gotTrace.Children, 2)
+
+	// constants are not traced
+	equality := gotTrace.Children[0]
+	assert.Equal(t, aladino.BuildBoolValue(true), equality.Value)
+	assert.Len(t, equality.Children, 1)
+
+	call := equality.Children[0]
+	assert.Equal(t, aladino.BuildStringValue("a"), call.Value)
+	assert.Equal(t, &aladino.TraceCall{Name: "returnStr", Args: []aladino.Value{aladino.BuildStringValue("a")}}, call.Call)
+}
+
+func TestExplain_WhenBuiltInIsCached(t *testing.T) {
+	builtIns := aladino.MockBuiltIns()
+	builtIns.Functions["size"] = &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildIntType()),
+		Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildIntValue(12), nil
+		},
+		Pure: true,
+	}
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	_, gotTrace, err := aladino.Explain(mockedEnv, `$size() > 10 && $size() < 20`)
+
+	assert.Nil(t, err)
+	assert.False(t, gotTrace.Children[0].Children[0].Call.Cached)
+	assert.True(t, gotTrace.Children[1].Children[0].Call.Cached)
+}
+
+func TestExplain_WhenEvaluationFails(t *testing.T) {
+	builtIns := aladino.MockBuiltIns()
+	builtIns.Functions["unavailable"] = &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
+		Code: func(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+			return nil, fmt.Errorf("service unavailable")
+		},
+	}
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, builtIns, nil)
+
+	gotResult, gotTrace, err := aladino.Explain(mockedEnv, `try($unavailable(), true) && $unavailable()`)
+
+	assert.EqualError(t, err, "service unavailable")
+	assert.False(t, gotResult)
+	assert.EqualError(t, gotTrace.Err, "service unavailable")
+
+	fallback := gotTrace.Children[0]
+	assert.Equal(t, aladino.BuildBoolValue(true), fallback.Value)
+	assert.EqualError(t, fallback.Children[0].Err, "service unavailable")
+
+	assert.EqualError(t, gotTrace.Children[1].Err, "service unavailable")
+}
+
+func TestExplain_WhenExpressionIsNotACondition(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	_, gotTrace, err := aladino.Explain(mockedEnv, `$zeroConst()`)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, gotTrace)
+}
+
+func TestTraceNodeString(t *testing.T) {
+	sizeCall := aladino.BuildFunctionCall(aladino.BuildVariable("size"), []aladino.Expr{})
+	longName := strings.Repeat("a", 100)
+
+	trace := &aladino.TraceNode{
+		Expr:     aladino.BuildGreaterThanOp(sizeCall, aladino.BuildIntConst(10)),
+		Value:    aladino.BuildBoolValue(true),
+		Duration: 1500 * time.Microsecond,
+		Children: []*aladino.TraceNode{
+			{
+				Expr:     sizeCall,
+				Value:    aladino.BuildIntValue(12),
+				Duration: time.Millisecond,
+				Call:     &aladino.TraceCall{Name: "size", Args: []aladino.Value{aladino.BuildStringValue(longName)}, Cached: true},
+			},
+		},
+	}
+
+	wantTrace := "$size() > 10 = true [2ms]\n" +
+		"  $size() = 12 [1ms]\n" +
+		"    calls size(\"" + strings.Repeat("a", 79) + "...) (cached)\n"
+
+	assert.Equal(t, wantTrace, trace.String())
+}
+
+func TestTraceNodeString_WhenEvaluationFails(t *testing.T) {
+	trace := &aladino.TraceNode{
+		Expr: aladino.BuildFunctionCall(aladino.BuildVariable("unavailable"), []aladino.Expr{}),
+		Err:  fmt.Errorf("service unavailable"),
+	}
+
+	assert.Equal(t, "$unavailable() = error: service unavailable [0s]\n", trace.String())
+}
@@ -4,18 +4,30 @@
 
 package aladino
 
+import (
+	"fmt"
+	"strings"
+)
+
 type Type interface {
 	Kind() string
 	equals(other Type) bool
 }
 
 const (
-	BOOL_TYPE     string = "BoolType"
-	INT_TYPE      string = "IntType"
-	STRING_TYPE   string = "StringType"
-	FUNCTION_TYPE string = "FunctionType"
-	ARRAY_TYPE    string = "ArrayType"
-	ARRAY_OF_TYPE string = "ArrayOfType"
+	BOOL_TYPE      string = "BoolType"
+	INT_TYPE       string = "IntType"
+	STRING_TYPE    string = "StringType"
+	FUNCTION_TYPE  string = "FunctionType"
+	ARRAY_TYPE     string = "ArrayType"
+	ARRAY_OF_TYPE  string = "ArrayOfType"
+	TYPE_VARIABLE  string = "TypeVariable"
+	RECORD_TYPE    string = "RecordType"
+	MAP_TYPE       string = "MapType"
+	TIMESTAMP_TYPE string = "TimestampType"
+	DURATION_TYPE  string = "DurationType"
+	REGEX_TYPE     string = "RegexType"
+	OPTIONAL_TYPE  string = "OptionalType"
 )
 
 type StringType struct{}
@@ -24,9 +36,25 @@ type IntType struct{}
 
 type BoolType struct{}
 
+// TimestampType is the type of points in time, e.g. 2022-04-05T22:01:50Z.
+type TimestampType struct{}
+
+// DurationType is the type of amounts of time, e.g. 48 hours.
+type DurationType struct{}
+
+// RegexType is the type of regular expressions, e.g. r`^docs/.*\.md$`.
+type RegexType struct{}
+
 type FunctionType struct {
 	paramTypes []Type
 	returnType Type
+	// defaults are the values of the optional parameters, which are the last ones.
+	defaults []Value
+	// variadicType is the type of the arguments that follow the parameters of a variadic function.
+	// These arguments are passed to the function as a single array.
+	variadicType Type
+	// paramNames are the names of the parameters, which can be used to pass the arguments by name.
+	paramNames []string
 }
 
 type ArrayOfType struct {
@@ -38,12 +66,78 @@ type ArrayType struct {
 	elemsType []Type
 }
 
+// RecordType is the type of values made of named fields.
+type RecordType struct {
+	fields map[string]Type
+}
+
+// MapType is the type of maps from strings to values of the same type.
+type MapType struct {
+	valueType Type
+}
+
+// OptionalType is the type of values that may be absent, e.g. the status of a workflow that did not run.
+type OptionalType struct {
+	elemType Type
+}
+
+// TypeVariable stands for any type in the type of a generic built-in.
+// For instance, the type of filter is ([]a, (a) => Bool) => []a.
+type TypeVariable struct {
+	name string
+}
+
 func BuildStringType() *StringType { return &StringType{} }
 func BuildIntType() *IntType       { return &IntType{} }
 func BuildBoolType() *BoolType     { return &BoolType{} }
 
+func BuildTimestampType() *TimestampType { return &TimestampType{} }
+func BuildDurationType() *DurationType   { return &DurationType{} }
+func BuildRegexType() *RegexType         { return &RegexType{} }
+
 func BuildFunctionType(paramsTypes []Type, returnType Type) *FunctionType {
-	return &FunctionType{paramsTypes, returnType}
+	return &FunctionType{paramTypes: paramsTypes, returnType: returnType}
+}
+
+// BuildFunctionTypeWithDefaults builds the type of a function whose last parameters are optional.
+// The arguments left out of a call take the default values.
+// For instance, the type of merge is (String = "merge") => Nil.
+func BuildFunctionTypeWithDefaults(paramsTypes []Type, defaults []Value, returnType Type) *FunctionType {
+	return &FunctionType{paramTypes: paramsTypes, returnType: returnType, defaults: defaults}
+}
+
+// BuildVariadicFunctionType builds the type of a function that takes
+// any number of arguments of variadicType after the parameters.
+// For instance, the type of addLabel is (String, ...String) => Nil.
+func BuildVariadicFunctionType(paramsTypes []Type, variadicType Type, returnType Type) *FunctionType {
+	return &FunctionType{paramTypes: paramsTypes, returnType: returnType, variadicType: variadicType}
+}
+
+// WithParamNames names the parameters of the function,
+// so that calls can pass the arguments by name - e.g. $merge(method: "squash").
+func (fTy *FunctionType) WithParamNames(names ...string) *FunctionType {
+	fTy.paramNames = names
+	return fTy
+}
+
+// paramIndex returns the position of the parameter with the given name, or -1 if there is no such parameter.
+func (fTy *FunctionType) paramIndex(name string) int {
+	for i, paramName := range fTy.paramNames {
+		if paramName == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// paramName returns the name of the i-th parameter, or an empty string when the parameters are not named.
+func (fTy *FunctionType) paramName(i int) string {
+	if i >= len(fTy.paramNames) {
+		return ""
+	}
+
+	return fTy.paramNames[i]
 }
 
 func BuildArrayOfType(elemType Type) *ArrayOfType {
@@ -54,6 +148,109 @@ func BuildArrayType(elemsTypes []Type) *ArrayType {
 	return &ArrayType{elemsTypes}
 }
 
+func BuildTypeVariable(name string) *TypeVariable {
+	return &TypeVariable{name}
+}
+
+func BuildRecordType(fields map[string]Type) *RecordType {
+	return &RecordType{fields}
+}
+
+func BuildMapType(valueType Type) *MapType {
+	return &MapType{valueType}
+}
+
+func BuildOptionalType(elemType Type) *OptionalType {
+	return &OptionalType{elemType}
+}
+
+// BuildCommitType returns the type of the commits of a pull request.
+func BuildCommitType() *RecordType {
+	return BuildRecordType(map[string]Type{
+		"sha":     BuildStringType(),
+		"author":  BuildStringType(),
+		"message": BuildStringType(),
+		"date":    BuildTimestampType(),
+	})
+}
+
+// BuildReviewType returns the type of the reviews of a pull request.
+func BuildReviewType() *RecordType {
+	return BuildRecordType(map[string]Type{
+		"user":        BuildStringType(),
+		"state":       BuildStringType(),
+		"submittedAt": BuildTimestampType(),
+	})
+}
+
+// BuildFileType returns the type of the files changed by a pull request.
+func BuildFileType() *RecordType {
+	return BuildRecordType(map[string]Type{
+		"path":      BuildStringType(),
+		"additions": BuildIntType(),
+		"deletions": BuildIntType(),
+		"status":    BuildStringType(),
+	})
+}
+
+// parseType returns the type with the given name.
+// The names are the ones used in the reviewpad file: Bool, Int, String, Timestamp, Duration, Regex,
+// the records Commit, Review and File, []T for arrays whose elements have type T
+// map[String]T for maps whose values have type T and Optional[T] for values of type T that may be absent.
+func parseType(name string) (Type, error) {
+	name = strings.TrimSpace(name)
+
+	switch name {
+	case "Bool":
+		return BuildBoolType(), nil
+	case "Int":
+		return BuildIntType(), nil
+	case "String":
+		return BuildStringType(), nil
+	case "Timestamp":
+		return BuildTimestampType(), nil
+	case "Duration":
+		return BuildDurationType(), nil
+	case "Regex":
+		return BuildRegexType(), nil
+	case "Commit":
+		return BuildCommitType(), nil
+	case "Review":
+		return BuildReviewType(), nil
+	case "File":
+		return BuildFileType(), nil
+	}
+
+	if strings.HasPrefix(name, "[]") {
+		elemType, err := parseType(name[2:])
+		if err != nil {
+			return nil, err
+		}
+
+		return BuildArrayOfType(elemType), nil
+	}
+
+	if strings.HasPrefix(name, "Optional[") && strings.HasSuffix(name, "]") {
+		elemType, err := parseType(strings.TrimSuffix(strings.TrimPrefix(name, "Optional["), "]"))
+		if err != nil {
+			return nil, err
+		}
+
+		return BuildOptionalType(elemType), nil
+	}
+
+	if strings.HasPrefix(name, "map[String]") {
+		valueType, err := parseType(strings.TrimPrefix(name, "map[String]"))
+		if err != nil {
+			return nil, err
+		}
+
+		return BuildMapType(valueType), nil
+	}
+
+	return nil, fmt.Errorf("unknown type %q", name)
+}
+
 func (bTy *BoolType) Kind() string {
 	return BOOL_TYPE
 }
@@ -78,6 +275,34 @@ func (aTy *ArrayOfType) Kind() string {
 	return ARRAY_OF_TYPE
 }
 
+func (rTy *RecordType) Kind() string {
+	return RECORD_TYPE
+}
+
+func (tTy *TimestampType) Kind() string {
+	return TIMESTAMP_TYPE
+}
+
+func (dTy *DurationType) Kind() string {
+	return DURATION_TYPE
+}
+
+func (rTy *RegexType) Kind() string {
+	return REGEX_TYPE
+}
+
+func (mTy *MapType) Kind() string {
+	return MAP_TYPE
+}
+
+func (oTy *OptionalType) Kind() string {
+	return OPTIONAL_TYPE
+}
+
+func (tVar *TypeVariable) Kind() string {
+	return TYPE_VARIABLE
+}
+
 // Equals
 // equals on arrays
 func equals(leftTys []Type, rightTys []Type) bool {
@@ -117,7 +342,54 @@ func (thisTy *FunctionType) equals(thatTy Type) bool {
 	argsCheck := equals(thisTy.paramTypes, thatTyFunction.paramTypes)
 	retCheck := thisTy.returnType.equals(thatTyFunction.returnType)
 
-	return argsCheck && retCheck
+	return argsCheck && retCheck && thisTy.hasSameOptionalParams(thatTyFunction)
+}
+
+func (thisTy *FunctionType) hasSameOptionalParams(thatTy *FunctionType) bool {
+	if len(thisTy.defaults) != len(thatTy.defaults) {
+		return false
+	}
+
+	for i, defaultVal := range thisTy.defaults {
+		if !defaultVal.Equals(thatTy.defaults[i]) {
+			return false
+		}
+	}
+
+	if thisTy.variadicType == nil || thatTy.variadicType == nil {
+		return thisTy.variadicType == thatTy.variadicType
+	}
+
+	return thisTy.variadicType.equals(thatTy.variadicType)
+}
+
+// completeArgs fills in the default values of the arguments left out of a call
+// and packs the extra arguments of a variadic function into an array,
+// so that the function always receives one argument per parameter.
+func (fTy *FunctionType) completeArgs(args []Value) []Value {
+	nParams := len(fTy.paramTypes)
+	nRequired := nParams - len(fTy.defaults)
+	if len(args) < nRequired {
+		// The call was not type checked, so the function gets the arguments as they are.
+		return args
+	}
+
+	if len(args) < nParams {
+		missing := fTy.defaults[len(args)-nRequired:]
+
+		completeArgs := make([]Value, 0, nParams)
+		completeArgs = append(completeArgs, args...)
+		args = append(completeArgs, missing...)
+	}
+
+	if fTy.variadicType == nil {
+		return args
+	}
+
+	extraArgs := make([]Value, len(args)-nParams)
+	copy(extraArgs, args[nParams:])
+
+	return append(args[:nParams:nParams], BuildArrayValue(extraArgs))
 }
 
 func (thisTy *ArrayType) equals(thatTy Type) bool {
@@ -146,3 +418,59 @@ func (thisTy *ArrayOfType) equals(thatTy Type) bool {
 	}
 	return false
 }
+
+func (thisTy *TimestampType) equals(thatTy Type) bool {
+	return thisTy.Kind() == thatTy.Kind()
+}
+
+func (thisTy *DurationType) equals(thatTy Type) bool {
+	return thisTy.Kind() == thatTy.Kind()
+}
+
+func (thisTy *RegexType) equals(thatTy Type) bool {
+	return thisTy.Kind() == thatTy.Kind()
+}
+
+func (thisTy *MapType) equals(thatTy Type) bool {
+	if thisTy.Kind() != thatTy.Kind() {
+		return false
+	}
+
+	return thisTy.valueType.equals(thatTy.(*MapType).valueType)
+}
+
+func (thisTy *OptionalType) equals(thatTy Type) bool {
+	if thisTy.Kind() != thatTy.Kind() {
+		return false
+	}
+
+	return thisTy.elemType.equals(thatTy.(*OptionalType).elemType)
+}
+
+func (thisTy *TypeVariable) equals(thatTy Type) bool {
+	if thisTy.Kind() != thatTy.Kind() {
+		return false
+	}
+
+	return thisTy.name == thatTy.(*TypeVariable).name
+}
+
+func (thisTy *RecordType) equals(thatTy Type) bool {
+	if thisTy.Kind() != thatTy.Kind() {
+		return false
+	}
+
+	thatTyRecord := thatTy.(*RecordType)
+	if len(thisTy.fields) != len(thatTyRecord.fields) {
+		return false
+	}
+
+	for name, fieldTy := range thisTy.fields {
+		thatFieldTy, ok := thatTyRecord.fields[name]
+		if !ok || !fieldTy.equals(thatFieldTy) {
+			return false
+		}
+	}
+
+	return true
+}
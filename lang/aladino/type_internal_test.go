@@ -32,12 +32,34 @@ func TestBuildBoolType(t *testing.T) {
 }
 
 func TestBuildFunctionType(t *testing.T) {
-	wantVal := &FunctionType{[]Type{&StringType{}}, &StringType{}}
+	wantVal := &FunctionType{paramTypes: []Type{&StringType{}}, returnType: &StringType{}}
 	gotVal := BuildFunctionType([]Type{&StringType{}}, &StringType{})
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildFunctionTypeWithDefaults(t *testing.T) {
+	wantVal := &FunctionType{
+		paramTypes: []Type{&StringType{}},
+		returnType: &StringType{},
+		defaults:   []Value{BuildStringValue("merge")},
+	}
+	gotVal := BuildFunctionTypeWithDefaults([]Type{&StringType{}}, []Value{BuildStringValue("merge")}, &StringType{})
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildVariadicFunctionType(t *testing.T) {
+	wantVal := &FunctionType{
+		paramTypes:   []Type{&StringType{}},
+		returnType:   &StringType{},
+		variadicType: &StringType{},
+	}
+	gotVal := BuildVariadicFunctionType([]Type{&StringType{}}, &StringType{}, &StringType{})
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildArrayOfType(t *testing.T) {
 	wantVal := &ArrayOfType{&StringType{}}
 	gotVal := BuildArrayOfType(&StringType{})
@@ -240,3 +262,76 @@ func TestEquals_WhenArrayOfTypeComparedToStringType(t *testing.T) {
 
 	assert.False(t, arrayOfType.equals(otherType))
 }
+
+func TestParseType(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		wantType Type
+		wantErr  string
+	}{
+		"bool":            {name: "Bool", wantType: BuildBoolType()},
+		"int":             {name: "Int", wantType: BuildIntType()},
+		"string":          {name: "String", wantType: BuildStringType()},
+		"timestamp":       {name: "Timestamp", wantType: BuildTimestampType()},
+		"duration":        {name: "Duration", wantType: BuildDurationType()},
+		"regex":           {name: "Regex", wantType: BuildRegexType()},
+		"array of arrays": {name: "[][]String", wantType: BuildArrayOfType(BuildArrayOfType(BuildStringType()))},
+		"map":             {name: "map[String][]String", wantType: BuildMapType(BuildArrayOfType(BuildStringType()))},
+		"optional":        {name: "Optional[[]String]", wantType: BuildOptionalType(BuildArrayOfType(BuildStringType()))},
+		"unknown":         {name: "[]Number", wantErr: "unknown type \"Number\""},
+		"unknown map key": {name: "map[Int]String", wantErr: "unknown type \"map[Int]String\""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotType, err := parseType(test.name)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestBuildTypeVariable(t *testing.T) {
+	wantVal := &TypeVariable{name: "a"}
+	gotVal := BuildTypeVariable("a")
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestKind_WhenTypeVariable(t *testing.T) {
+	assert.Equal(t, TYPE_VARIABLE, BuildTypeVariable("a").Kind())
+}
+
+func TestEquals_WhenTypeVariablesHaveSameName(t *testing.T) {
+	assert.True(t, BuildTypeVariable("a").equals(BuildTypeVariable("a")))
+}
+
+func TestEquals_WhenTypeVariablesHaveDiffNames(t *testing.T) {
+	assert.False(t, BuildTypeVariable("a").equals(BuildTypeVariable("b")))
+}
+
+func TestEquals_WhenTypeVariableComparedToStringType(t *testing.T) {
+	assert.False(t, BuildTypeVariable("a").equals(BuildStringType()))
+}
+
+func TestEquals_WhenRecordTypesHaveSameFields(t *testing.T) {
+	assert.True(t, BuildCommitType().equals(BuildCommitType()))
+}
+
+func TestEquals_WhenRecordTypesHaveDiffFields(t *testing.T) {
+	assert.False(t, BuildCommitType().equals(BuildReviewType()))
+}
+
+func TestEquals_WhenMapTypesHaveSameValueType(t *testing.T) {
+	assert.True(t, BuildMapType(BuildIntType()).equals(BuildMapType(BuildIntType())))
+}
+
+func TestEquals_WhenMapTypesHaveDiffValueTypes(t *testing.T) {
+	assert.False(t, BuildMapType(BuildIntType()).equals(BuildMapType(BuildStringType())))
+}
@@ -4,7 +4,10 @@
 
 package aladino
 
-import "fmt"
+import (
+	"fmt"
+	"sync/atomic"
+)
 
 func TypeInference(e Env, expr Expr) (Type, error) {
 	return expr.typeinfer(NewTypeEnv(e))
@@ -24,6 +27,27 @@ func typesinfer(env TypeEnv, exprs []Expr) ([]Type, error) {
 	return exprsTy, nil
 }
 
+// restore returns a function that restores the types of the identifiers
+// to the ones they have now. It is used to leave the scope where they are redefined.
+func (env TypeEnv) restore(idents []string) func() {
+	saved := make(TypeEnv)
+	for _, ident := range idents {
+		if ty, ok := env[ident]; ok {
+			saved[ident] = ty
+		}
+	}
+
+	return func() {
+		for _, ident := range idents {
+			if ty, ok := saved[ident]; ok {
+				env[ident] = ty
+			} else {
+				delete(env, ident)
+			}
+		}
+	}
+}
+
 func (u *UnaryOp) typeinfer(env TypeEnv) (Type, error) {
 	exprType, exprErr := u.expr.typeinfer(env)
 	if exprErr != nil {
@@ -35,8 +59,16 @@ func (u *UnaryOp) typeinfer(env TypeEnv) (Type, error) {
 		if exprType.Kind() == BOOL_TYPE {
 			return BuildBoolType(), nil
 		}
+	case NEG_OP:
+		if exprType.Kind() == INT_TYPE {
+			return BuildIntType(), nil
+		}
+
+		if exprType.equals(BuildDurationType()) {
+			return BuildDurationType(), nil
+		}
 	}
-	return nil, fmt.Errorf("type inference failed")
+	return nil, typeError(u.Pos(), "type inference failed")
 }
 
 func (b *BinaryOp) typeinfer(env TypeEnv) (Type, error) {
@@ -52,20 +84,104 @@ func (b *BinaryOp) typeinfer(env TypeEnv) (Type, error) {
 
 	switch b.op.getOperator() {
 	case EQ_OP, NEQ_OP:
-		if lhsType.equals(rhsType) {
+		// Generic values such as $none() can be compared with values of any type that fits them.
+		if lhsType.equals(rhsType) || make(substitution).unify(lhsType, rhsType) {
+			return BuildBoolType(), nil
+		}
+
+		// Built-ins such as $milestone() used to return "" when there was no value.
+		// Now that they return optional values, comparing them with "" would always be false.
+		if (isOptional(lhsType) && isEmptyString(b.rhs)) || (isOptional(rhsType) && isEmptyString(b.lhs)) {
+			return nil, typeError(b.Pos(), "an optional value is never equal to \"\", use $isSet to check if it has a value")
+		}
+
+		// An optional value can be compared with a value of its element type, which is lifted to $some of it,
+		// e.g. $workflowStatus("build") == "success".
+		if isOptionalOf(lhsType, rhsType) || isOptionalOf(rhsType, lhsType) {
 			return BuildBoolType(), nil
 		}
 	case GREATER_EQ_THAN_OP, GREATER_THAN_OP, LESS_EQ_THAN_OP, LESS_THAN_OP:
-		if lhsType.equals(BuildIntType()) && rhsType.equals(BuildIntType()) {
+		// integers, timestamps and durations are ordered
+		if isOrdered(lhsType) && lhsType.equals(rhsType) {
+			return BuildBoolType(), nil
+		}
+
+		// timestamps are also compared with integers as Unix seconds,
+		// which was the type of timestamps such as $createdAt() before there was a timestamp type
+		if isTimestampOrInt(lhsType) && isTimestampOrInt(rhsType) {
 			return BuildBoolType(), nil
 		}
 	case AND_OP, OR_OP:
 		if lhsType.equals(BuildBoolType()) && rhsType.equals(BuildBoolType()) {
 			return BuildBoolType(), nil
 		}
+	case PLUS_OP:
+		if lhsType.equals(BuildIntType()) && rhsType.equals(BuildIntType()) {
+			return BuildIntType(), nil
+		}
+
+		// + on strings is concatenation
+		if lhsType.equals(BuildStringType()) && rhsType.equals(BuildStringType()) {
+			return BuildStringType(), nil
+		}
+
+		// timestamps are shifted by durations and durations are added together
+		if (lhsType.equals(BuildTimestampType()) || lhsType.equals(BuildDurationType())) && rhsType.equals(BuildDurationType()) {
+			return lhsType, nil
+		}
+
+		if lhsType.equals(BuildDurationType()) && rhsType.equals(BuildTimestampType()) {
+			return BuildTimestampType(), nil
+		}
+	case MINUS_OP:
+		if lhsType.equals(BuildIntType()) && rhsType.equals(BuildIntType()) {
+			return BuildIntType(), nil
+		}
+
+		// the difference between two timestamps is a duration
+		if lhsType.equals(BuildTimestampType()) && rhsType.equals(BuildTimestampType()) {
+			return BuildDurationType(), nil
+		}
+
+		if (lhsType.equals(BuildTimestampType()) || lhsType.equals(BuildDurationType())) && rhsType.equals(BuildDurationType()) {
+			return lhsType, nil
+		}
+	case MATCH_OP, NOT_MATCH_OP:
+		if lhsType.equals(BuildStringType()) && rhsType.equals(BuildRegexType()) {
+			return BuildBoolType(), nil
+		}
+	case MULT_OP, DIV_OP, MOD_OP:
+		if lhsType.equals(BuildIntType()) && rhsType.equals(BuildIntType()) {
+			return BuildIntType(), nil
+		}
 	}
 
-	return nil, fmt.Errorf("type inference failed")
+	return nil, typeError(b.Pos(), "type inference failed")
+}
+
+func isOptional(ty Type) bool {
+	_, ok := ty.(*OptionalType)
+	return ok
+}
+
+// isEmptyString checks if expr is the string literal "".
+func isEmptyString(expr Expr) bool {
+	str, ok := expr.(*StringConst)
+	return ok && str.value == ""
+}
+
+// isOptionalOf checks if optionalTy is the type of the optional values of elemTy.
+func isOptionalOf(optionalTy, elemTy Type) bool {
+	optionalType, ok := optionalTy.(*OptionalType)
+	return ok && make(substitution).unify(optionalType.elemType, elemTy)
+}
+
+func isOrdered(ty Type) bool {
+	return ty.equals(BuildIntType()) || ty.equals(BuildTimestampType()) || ty.equals(BuildDurationType())
+}
+
+func isTimestampOrInt(ty Type) bool {
+	return ty.equals(BuildIntType()) || ty.equals(BuildTimestampType())
 }
 
 func (fc *FunctionCall) typeinfer(env TypeEnv) (Type, error) {
@@ -79,15 +195,102 @@ func (fc *FunctionCall) typeinfer(env TypeEnv) (Type, error) {
 		return nil, err
 	}
 
-	ty := fcType.(*FunctionType)
-	if equals(argsTy, ty.paramTypes) {
-		return ty.returnType, nil
+	ty, ok := fcType.(*FunctionType)
+	if !ok {
+		return nil, typeError(fc.Pos(), "%v is not a function", fc.name.ident)
+	}
+
+	// Each call to a generic built-in gets its own type variables.
+	ty = instantiate(ty).(*FunctionType)
+
+	bindings, err := fc.bindArgs(ty)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("type inference failed: mismatch in arg types on %v", fc.name.ident)
+	subst := make(substitution)
+	for param, arg := range bindings {
+		if arg == -1 {
+			continue
+		}
+
+		if !subst.unify(ty.paramTypes[param], argsTy[arg]) {
+			if paramName := ty.paramName(param); paramName != "" {
+				return nil, typeError(fc.arguments[arg].Pos(), "type inference failed: mismatch in arg types on %v: wrong type for parameter %v", fc.name.ident, paramName)
+			}
+			return nil, fc.mismatchError()
+		}
+	}
+
+	for arg := len(ty.paramTypes); arg < len(argsTy); arg++ {
+		if !subst.unify(ty.instantiateVariadicType(), argsTy[arg]) {
+			return nil, fc.mismatchError()
+		}
+	}
+
+	return subst.apply(ty.returnType), nil
+}
+
+// bindArgs returns the index of the argument given to each parameter of the function,
+// or -1 when the argument is left out and the parameter takes its default value.
+// The arguments that follow the parameters of a variadic function are not bound to any parameter.
+func (fc *FunctionCall) bindArgs(fTy *FunctionType) ([]int, error) {
+	nParams := len(fTy.paramTypes)
+	nRequired := nParams - len(fTy.defaults)
+
+	bindings := make([]int, nParams)
+	for param := range bindings {
+		bindings[param] = -1
+	}
+
+	for arg, argExpr := range fc.arguments {
+		param := arg
+		if argName := fc.argName(arg); argName != "" {
+			param = fTy.paramIndex(argName)
+			if param == -1 {
+				return nil, typeError(argExpr.Pos(), "%v has no parameter %v", fc.name.ident, argName)
+			}
+
+			if bindings[param] != -1 {
+				return nil, typeError(argExpr.Pos(), "parameter %v of %v is given more than once", argName, fc.name.ident)
+			}
+		} else if arg >= nParams {
+			if fTy.variadicType == nil {
+				return nil, fc.mismatchError()
+			}
+
+			// the extra arguments come before the named ones, so they would take the place of the named parameters
+			if fc.argNames != nil {
+				return nil, typeError(argExpr.Pos(), "%v cannot be given extra arguments together with named arguments", fc.name.ident)
+			}
+			continue
+		}
+
+		bindings[param] = arg
+	}
+
+	for param := 0; param < nRequired; param++ {
+		if bindings[param] != -1 {
+			continue
+		}
+
+		if paramName := fTy.paramName(param); paramName != "" {
+			return nil, typeError(fc.Pos(), "type inference failed: missing argument for parameter %v of %v", paramName, fc.name.ident)
+		}
+		return nil, fc.mismatchError()
+	}
+
+	return bindings, nil
+}
+
+func (fc *FunctionCall) mismatchError() error {
+	return typeError(fc.Pos(), "type inference failed: mismatch in arg types on %v", fc.name.ident)
 }
 
 func (l *Lambda) typeinfer(env TypeEnv) (Type, error) {
+	// The parameters are only visible in the body of the lambda.
+	defer env.restore(l.paramIdents())()
+
 	paramsTy, err := typesinfer(env, l.parameters)
 	if err != nil {
 		return nil, err
@@ -103,7 +306,7 @@ func (l *Lambda) typeinfer(env TypeEnv) (Type, error) {
 
 func (te *TypedExpr) typeinfer(env TypeEnv) (Type, error) {
 	if te.expr.Kind() != VARIABLE_CONST {
-		return nil, fmt.Errorf("typed expression %v is not a variable", te.expr)
+		return nil, typeError(te.Pos(), "typed expression %v is not a variable", te.expr)
 	}
 
 	varIdent := te.expr.(*Variable).ident
@@ -117,7 +320,7 @@ func (v *Variable) typeinfer(env TypeEnv) (Type, error) {
 	varName := v.ident
 	varType, ok := env[varName]
 	if !ok {
-		return nil, fmt.Errorf("no type for built-in %v. Please check if the mode in the reviewpad.yml file supports it", varName)
+		return nil, typeError(v.Pos(), "no type for built-in %v. Please check if the mode in the reviewpad.yml file supports it", varName)
 	}
 
 	return varType, nil
@@ -131,10 +334,166 @@ func (i *IntConst) typeinfer(env TypeEnv) (Type, error) {
 	return BuildIntType(), nil
 }
 
+func (r *RegexConst) typeinfer(env TypeEnv) (Type, error) {
+	if _, err := r.compile(); err != nil {
+		return nil, typeError(r.Pos(), "invalid regular expression %q: %v", r.pattern, err)
+	}
+
+	return BuildRegexType(), nil
+}
+
+func (t *TimeConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildTimestampType(), nil
+}
+
+func (d *DurationConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildDurationType(), nil
+}
+
 func (b *BoolConst) typeinfer(env TypeEnv) (Type, error) {
 	return BuildBoolType(), nil
 }
 
+func (fa *FieldAccess) typeinfer(env TypeEnv) (Type, error) {
+	exprType, err := fa.expr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	recordType, ok := exprType.(*RecordType)
+	if !ok {
+		return nil, typeError(fa.Pos(), "type inference failed: field %v of a value that is not a record", fa.field)
+	}
+
+	fieldType, ok := recordType.fields[fa.field]
+	if !ok {
+		return nil, typeError(fa.Pos(), "type inference failed: unknown field %v", fa.field)
+	}
+
+	return fieldType, nil
+}
+
+func (m *Map) typeinfer(env TypeEnv) (Type, error) {
+	subst := make(substitution)
+	valueType := freshTypeVariable("v")
+
+	for _, entry := range m.entries {
+		keyType, err := entry.key.typeinfer(env)
+		if err != nil {
+			return nil, err
+		}
+
+		if !subst.unify(BuildStringType(), keyType) {
+			return nil, typeError(entry.key.Pos(), "type inference failed: map keys must be strings")
+		}
+
+		entryValueType, err := entry.value.typeinfer(env)
+		if err != nil {
+			return nil, err
+		}
+
+		if !subst.unify(valueType, subst.asArrayOf(entryValueType)) {
+			return nil, typeError(entry.value.Pos(), "type inference failed: map values must have the same type")
+		}
+	}
+
+	return BuildMapType(subst.apply(valueType)), nil
+}
+
+func (ia *IndexAccess) typeinfer(env TypeEnv) (Type, error) {
+	exprType, err := ia.expr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	indexType, err := ia.index.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	subst := make(substitution)
+
+	switch exprType := subst.asArrayOf(exprType).(type) {
+	case *ArrayOfType:
+		if !subst.unify(BuildIntType(), indexType) {
+			return nil, typeError(ia.index.Pos(), "type inference failed: array index must be an integer")
+		}
+
+		return subst.apply(exprType.elemType), nil
+	case *MapType:
+		if !subst.unify(BuildStringType(), indexType) {
+			return nil, typeError(ia.index.Pos(), "type inference failed: map key must be a string")
+		}
+
+		return subst.apply(exprType.valueType), nil
+	}
+
+	return nil, typeError(ia.Pos(), "type inference failed: index of a value that is not an array or a map")
+}
+
+func (c *Conditional) typeinfer(env TypeEnv) (Type, error) {
+	condType, err := c.cond.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if condType.Kind() != BOOL_TYPE {
+		return nil, typeError(c.cond.Pos(), "type inference failed: condition of if expression is not a boolean")
+	}
+
+	thenType, err := c.thenExpr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	elseType, err := c.elseExpr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	subst := make(substitution)
+	thenType = subst.asArrayOf(thenType)
+	if !subst.unify(thenType, subst.asArrayOf(elseType)) {
+		return nil, typeError(c.Pos(), "type inference failed: branches of if expression have different types")
+	}
+
+	return subst.apply(thenType), nil
+}
+
+func (l *Let) typeinfer(env TypeEnv) (Type, error) {
+	valueType, err := l.value.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	// The variable is only visible in the body.
+	defer env.restore([]string{l.variable.ident})()
+
+	env[l.variable.ident] = valueType
+
+	return l.body.typeinfer(env)
+}
+
+func (t *Try) typeinfer(env TypeEnv) (Type, error) {
+	exprType, err := t.expr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	fallbackType, err := t.fallback.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	subst := make(substitution)
+	exprType = subst.asArrayOf(exprType)
+	if !subst.unify(exprType, subst.asArrayOf(fallbackType)) {
+		return nil, typeError(t.fallback.Pos(), "type inference failed: fallback of try expression has a different type")
+	}
+
+	return subst.apply(exprType), nil
+}
+
 func (a *Array) typeinfer(env TypeEnv) (Type, error) {
 	elemsTy, err := typesinfer(env, a.elems)
 	if err != nil {
@@ -143,3 +502,249 @@ func (a *Array) typeinfer(env TypeEnv) (Type, error) {
 
 	return BuildArrayType(elemsTy), nil
 }
+
+// substitution maps type variables, by name, to the types they stand for.
+type substitution map[string]Type
+
+// typeVariablesCount is used to give fresh names to type variables.
+var typeVariablesCount uint64
+
+// instantiate renames the type variables in ty to fresh ones
+// so that they are not mistaken with the type variables of other types.
+func instantiate(ty Type) Type {
+	renaming := make(substitution)
+	for _, name := range typeVariables(ty) {
+		if _, ok := renaming[name]; !ok {
+			renaming[name] = freshTypeVariable(name)
+		}
+	}
+
+	return renaming.apply(ty)
+}
+
+// instantiateVariadicType renames the type variables that only occur in the variadic type,
+// so that each extra argument can have a different type, e.g. $sprintf("%v %v", 1, "a").
+func (fTy *FunctionType) instantiateVariadicType() Type {
+	bound := make(map[string]bool)
+	for _, name := range typeVariables(BuildFunctionType(fTy.paramTypes, fTy.returnType)) {
+		bound[name] = true
+	}
+
+	renaming := make(substitution)
+	for _, name := range typeVariables(fTy.variadicType) {
+		if !bound[name] {
+			renaming[name] = freshTypeVariable(name)
+		}
+	}
+
+	return renaming.apply(fTy.variadicType)
+}
+
+// freshTypeVariable returns a type variable that is not used anywhere else.
+func freshTypeVariable(name string) *TypeVariable {
+	count := atomic.AddUint64(&typeVariablesCount, 1)
+	return BuildTypeVariable(fmt.Sprintf("%v%v", name, count))
+}
+
+// asArrayOf turns the type of an array literal whose elements have the same type, e.g. ["a", "b"],
+// into the type of arrays of that type, e.g. []String. Any other type is returned untouched.
+func (subst substitution) asArrayOf(ty Type) Type {
+	if _, ok := ty.(*ArrayType); !ok {
+		return ty
+	}
+
+	arrayOfType := BuildArrayOfType(freshTypeVariable("e"))
+	if !subst.unify(arrayOfType, ty) {
+		return ty
+	}
+
+	return subst.apply(arrayOfType)
+}
+
+func typeVariables(ty Type) []string {
+	switch t := ty.(type) {
+	case *TypeVariable:
+		return []string{t.name}
+	case *FunctionType:
+		names := typeVariables(t.returnType)
+		for _, paramType := range t.paramTypes {
+			names = append(names, typeVariables(paramType)...)
+		}
+		if t.variadicType != nil {
+			names = append(names, typeVariables(t.variadicType)...)
+		}
+		return names
+	case *ArrayOfType:
+		return typeVariables(t.elemType)
+	case *ArrayType:
+		names := make([]string, 0)
+		for _, elemType := range t.elemsType {
+			names = append(names, typeVariables(elemType)...)
+		}
+		return names
+	case *MapType:
+		return typeVariables(t.valueType)
+	case *OptionalType:
+		return typeVariables(t.elemType)
+	case *RecordType:
+		names := make([]string, 0)
+		for _, fieldType := range t.fields {
+			names = append(names, typeVariables(fieldType)...)
+		}
+		return names
+	}
+
+	return []string{}
+}
+
+// resolve returns the type that ty stands for in the substitution.
+func (subst substitution) resolve(ty Type) Type {
+	for {
+		tVar, ok := ty.(*TypeVariable)
+		if !ok {
+			return ty
+		}
+
+		boundTy, ok := subst[tVar.name]
+		if !ok {
+			return ty
+		}
+
+		ty = boundTy
+	}
+}
+
+// apply replaces all the type variables in ty by the types they stand for.
+func (subst substitution) apply(ty Type) Type {
+	switch t := subst.resolve(ty).(type) {
+	case *FunctionType:
+		paramTypes := make([]Type, len(t.paramTypes))
+		for i, paramType := range t.paramTypes {
+			paramTypes[i] = subst.apply(paramType)
+		}
+		fnType := BuildFunctionTypeWithDefaults(paramTypes, t.defaults, subst.apply(t.returnType)).WithParamNames(t.paramNames...)
+		if t.variadicType != nil {
+			fnType.variadicType = subst.apply(t.variadicType)
+		}
+		return fnType
+	case *ArrayOfType:
+		return BuildArrayOfType(subst.apply(t.elemType))
+	case *ArrayType:
+		elemsType := make([]Type, len(t.elemsType))
+		for i, elemType := range t.elemsType {
+			elemsType[i] = subst.apply(elemType)
+		}
+		return BuildArrayType(elemsType)
+	case *MapType:
+		return BuildMapType(subst.apply(t.valueType))
+	case *OptionalType:
+		return BuildOptionalType(subst.apply(t.elemType))
+	case *RecordType:
+		fields := make(map[string]Type, len(t.fields))
+		for name, fieldType := range t.fields {
+			fields[name] = subst.apply(fieldType)
+		}
+		return BuildRecordType(fields)
+	default:
+		return t
+	}
+}
+
+// unify extends the substitution so that both types become equal.
+// It returns false when there is no such substitution.
+func (subst substitution) unify(leftTy, rightTy Type) bool {
+	leftTy = subst.resolve(leftTy)
+	rightTy = subst.resolve(rightTy)
+
+	// built-ins without a return value have a nil return type
+	if leftTy == nil || rightTy == nil {
+		return leftTy == nil && rightTy == nil
+	}
+
+	if tVar, ok := leftTy.(*TypeVariable); ok {
+		return subst.bind(tVar, rightTy)
+	}
+
+	if tVar, ok := rightTy.(*TypeVariable); ok {
+		return subst.bind(tVar, leftTy)
+	}
+
+	switch leftTy := leftTy.(type) {
+	case *FunctionType:
+		rightTy, ok := rightTy.(*FunctionType)
+		return ok && subst.unifyAll(leftTy.paramTypes, rightTy.paramTypes) && subst.unify(leftTy.returnType, rightTy.returnType)
+	case *ArrayOfType:
+		switch rightTy := rightTy.(type) {
+		case *ArrayOfType:
+			return subst.unify(leftTy.elemType, rightTy.elemType)
+		case *ArrayType:
+			for _, elemType := range rightTy.elemsType {
+				if !subst.unify(leftTy.elemType, elemType) {
+					return false
+				}
+			}
+			return true
+		}
+		return false
+	case *ArrayType:
+		switch rightTy := rightTy.(type) {
+		case *ArrayOfType:
+			return subst.unify(rightTy, leftTy)
+		case *ArrayType:
+			return subst.unifyAll(leftTy.elemsType, rightTy.elemsType)
+		}
+		return false
+	case *MapType:
+		rightTy, ok := rightTy.(*MapType)
+		return ok && subst.unify(leftTy.valueType, rightTy.valueType)
+	case *OptionalType:
+		rightTy, ok := rightTy.(*OptionalType)
+		return ok && subst.unify(leftTy.elemType, rightTy.elemType)
+	case *RecordType:
+		rightTy, ok := rightTy.(*RecordType)
+		if !ok || len(leftTy.fields) != len(rightTy.fields) {
+			return false
+		}
+
+		for name, fieldType := range leftTy.fields {
+			rightFieldType, ok := rightTy.fields[name]
+			if !ok || !subst.unify(fieldType, rightFieldType) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return leftTy.equals(rightTy)
+}
+
+func (subst substitution) unifyAll(leftTys, rightTys []Type) bool {
+	if len(leftTys) != len(rightTys) {
+		return false
+	}
+
+	for i, leftTy := range leftTys {
+		if !subst.unify(leftTy, rightTys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bind makes the type variable stand for ty.
+// A type variable cannot stand for a type that contains it.
+func (subst substitution) bind(tVar *TypeVariable, ty Type) bool {
+	if otherVar, ok := ty.(*TypeVariable); ok && otherVar.name == tVar.name {
+		return true
+	}
+
+	for _, name := range typeVariables(subst.apply(ty)) {
+		if name == tVar.name {
+			return false
+		}
+	}
+
+	subst[tVar.name] = ty
+	return true
+}
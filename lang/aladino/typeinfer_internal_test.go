@@ -230,6 +230,55 @@ func TestTypeInfer_WhenBinaryOpHasOrOperator(t *testing.T) {
 	assert.Equal(t, wantType, gotType)
 }
 
+func TestTypeInfer_WhenBinaryOpHasArithmeticOperator(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	operators := []BinaryOperator{plusOperator(), minusOperator(), multOperator(), divOperator(), modOperator()}
+	for _, operator := range operators {
+		binaryOp := BuildBinaryOp(BuildIntConst(1), operator, BuildIntConst(1))
+		gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+		wantType := BuildIntType()
+
+		assert.Nil(t, err)
+		assert.Equal(t, wantType, gotType)
+	}
+}
+
+func TestTypeInfer_WhenBinaryOpHasPlusOperatorOnStrings(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildStringConst("a"), plusOperator(), BuildStringConst("b"))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildStringType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenBinaryOpHasMinusOperatorOnStrings(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildStringConst("a"), minusOperator(), BuildStringConst("b"))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestTypeInfer_WhenUnaryOpOperatorIsANegOp(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	unaryOp := BuildUnaryOp(negOperator(), BuildIntConst(1))
+	gotType, err := unaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildIntType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
 func TestTypeInfer_WhenBinaryOpOperatorIsNotAValidOp(t *testing.T) {
 	mockedTypeEnv := MockTypeEnv()
 
@@ -285,6 +334,189 @@ func TestTypeInfer_WhenFunctionCallHasMismatchInArgTypes(t *testing.T) {
 	assert.EqualError(t, err, "type inference failed: mismatch in arg types on returnStr")
 }
 
+func TestTypeInfer_WhenFunctionCallHasOptionalParams(t *testing.T) {
+	typeEnv := TypeEnv{
+		"merge": BuildFunctionTypeWithDefaults([]Type{BuildStringType()}, []Value{BuildStringValue("merge")}, BuildBoolType()),
+	}
+
+	tests := map[string]struct {
+		args    []Expr
+		wantErr string
+	}{
+		"without the optional argument": {
+			args: []Expr{},
+		},
+		"with the optional argument": {
+			args: []Expr{BuildStringConst("rebase")},
+		},
+		"with too many arguments": {
+			args:    []Expr{BuildStringConst("rebase"), BuildStringConst("squash")},
+			wantErr: "type inference failed: mismatch in arg types on merge",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotType, err := BuildFunctionCall(BuildVariable("merge"), test.args).typeinfer(typeEnv)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BuildBoolType(), gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenFunctionCallIsVariadic(t *testing.T) {
+	typeEnv := TypeEnv{
+		"sprintf": BuildVariadicFunctionType([]Type{BuildStringType()}, BuildTypeVariable("a"), BuildStringType()),
+		"labels":  BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType(), BuildBoolType()),
+	}
+
+	tests := map[string]struct {
+		expr    Expr
+		wantErr string
+	}{
+		"without extra arguments": {
+			expr: BuildFunctionCall(BuildVariable("sprintf"), []Expr{BuildStringConst("hello")}),
+		},
+		"with extra arguments of different types": {
+			expr: BuildFunctionCall(BuildVariable("sprintf"), []Expr{BuildStringConst("%v %v"), BuildIntConst(1), BuildBoolConst(true)}),
+		},
+		"with extra arguments of the wrong type": {
+			expr:    BuildFunctionCall(BuildVariable("labels"), []Expr{BuildStringConst("bug"), BuildIntConst(1)}),
+			wantErr: "type inference failed: mismatch in arg types on labels",
+		},
+		"without the required arguments": {
+			expr:    BuildFunctionCall(BuildVariable("labels"), []Expr{}),
+			wantErr: "type inference failed: mismatch in arg types on labels",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := test.expr.typeinfer(typeEnv)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestTypeInfer_WhenFunctionCallHasNamedArgs(t *testing.T) {
+	typeEnv := TypeEnv{
+		"assignReviewer": BuildFunctionTypeWithDefaults(
+			[]Type{BuildArrayOfType(BuildStringType()), BuildIntType()},
+			[]Value{BuildIntValue(99)},
+			BuildBoolType(),
+		).WithParamNames("reviewers", "total"),
+	}
+
+	tests := map[string]struct {
+		input   string
+		wantErr string
+	}{
+		"when all the arguments are named": {
+			input: `$assignReviewer(total: 1, reviewers: ["john"])`,
+		},
+		"when the optional argument is left out": {
+			input: `$assignReviewer(reviewers: ["john"])`,
+		},
+		"when named and positional arguments are mixed": {
+			input: `$assignReviewer(["john"], total: 1)`,
+		},
+		"when the parameter does not exist": {
+			input:   `$assignReviewer(["john"], count: 1)`,
+			wantErr: "type error at line 1, column 34: assignReviewer has no parameter count",
+		},
+		"when the parameter is given twice": {
+			input:   `$assignReviewer(["john"], reviewers: ["jane"])`,
+			wantErr: "type error at line 1, column 38: parameter reviewers of assignReviewer is given more than once",
+		},
+		"when a required argument is missing": {
+			input:   `$assignReviewer(total: 1)`,
+			wantErr: "type error at line 1, column 1: type inference failed: missing argument for parameter reviewers of assignReviewer",
+		},
+		"when an argument has the wrong type": {
+			input:   `$assignReviewer(["john"], total: "1")`,
+			wantErr: "type error at line 1, column 34: type inference failed: mismatch in arg types on assignReviewer: wrong type for parameter total",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.input)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(typeEnv)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BuildBoolType(), gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenVariadicFunctionCallHasNamedArgs(t *testing.T) {
+	typeEnv := TypeEnv{
+		"sprintf": BuildVariadicFunctionType([]Type{BuildStringType()}, BuildTypeVariable("a"), BuildStringType()).WithParamNames("format"),
+	}
+
+	expr, err := Parse(`$sprintf("%v", 1, format: "%v")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotType, err := expr.typeinfer(typeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type error at line 1, column 16: sprintf cannot be given extra arguments together with named arguments")
+}
+
+func TestTypeInfer_WhenFunctionCallIsNotOnFunction(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	expr, err := Parse(`($zeroConst: Int => $zeroConst())`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotType, err := expr.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type error at line 1, column 21: zeroConst is not a function")
+}
+
+func TestTypeInfer_WhenLambdaParamsAreScopedToItsBody(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	expr, err := Parse(`($returnStr: String, $param: Int => $returnStr)`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotType, err := expr.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFunctionType([]Type{BuildStringType(), BuildIntType()}, BuildStringType())
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+	assert.Equal(t, MockTypeEnv(), mockedTypeEnv)
+}
+
 func TestTypeInfer_WhenLambdaParamTypeHasError(t *testing.T) {
 	mockedTypeEnv := MockTypeEnv()
 
@@ -449,3 +681,479 @@ func TestTypeInfer_WhenArrayElemsTypeHasCorrectTypes(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantType, gotType)
 }
+
+func TestTypeInfer_WhenGenericFunctionIsCalled(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+	// ([]a, (a) => Bool) => []a
+	mockedTypeEnv["filter"] = BuildFunctionType(
+		[]Type{
+			BuildArrayOfType(BuildTypeVariable("a")),
+			BuildFunctionType([]Type{BuildTypeVariable("a")}, BuildBoolType()),
+		},
+		BuildArrayOfType(BuildTypeVariable("a")),
+	)
+
+	tests := map[string]struct {
+		expr     string
+		wantType Type
+		wantErr  string
+	}{
+		"when called on ints": {
+			expr:     `$filter([1, 2], ($n: Int => $n > 1))`,
+			wantType: BuildArrayOfType(BuildIntType()),
+		},
+		"when called on strings": {
+			expr:     `$filter($filter(["a"], ($s: String => true)), ($s: String => false))`,
+			wantType: BuildArrayOfType(BuildStringType()),
+		},
+		"when types do not unify": {
+			expr:    `$filter([1, 2], ($s: String => true))`,
+			wantErr: "type error at line 1, column 1: type inference failed: mismatch in arg types on filter",
+		},
+		"when array elements have different types": {
+			expr:    `$filter([1, "a"], ($n: Int => true))`,
+			wantErr: "type error at line 1, column 1: type inference failed: mismatch in arg types on filter",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(mockedTypeEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotType)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenFieldAccess(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+	mockedTypeEnv["lastCommit"] = BuildFunctionType([]Type{}, BuildCommitType())
+	mockedTypeEnv["total"] = BuildFunctionType([]Type{}, BuildIntType())
+
+	tests := map[string]struct {
+		expr     string
+		wantType Type
+		wantErr  string
+	}{
+		"when field exists": {
+			expr:     `$lastCommit().author`,
+			wantType: BuildStringType(),
+		},
+		"when field is accessed in lambda": {
+			expr:     `($c: Commit => $c.date > 2022-01-01)`,
+			wantType: BuildFunctionType([]Type{BuildCommitType()}, BuildBoolType()),
+		},
+		"when field is unknown": {
+			expr:    `$lastCommit().title`,
+			wantErr: "type error at line 1, column 14: type inference failed: unknown field title",
+		},
+		"when value is not a record": {
+			expr:    `$total().author`,
+			wantErr: "type error at line 1, column 9: type inference failed: field author of a value that is not a record",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(mockedTypeEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotType)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenMapAndIndexAccess(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	tests := map[string]struct {
+		expr     string
+		wantType Type
+		wantErr  string
+	}{
+		"when map has values of the same type": {
+			expr:     `{"api/": 2, "web/": 1}`,
+			wantType: BuildMapType(BuildIntType()),
+		},
+		"when map values are arrays of different lengths": {
+			expr:     `{"api/": ["john"], "web/": ["jane", "mary"]}`,
+			wantType: BuildMapType(BuildArrayOfType(BuildStringType())),
+		},
+		"when map is indexed": {
+			expr:     `{"api/": 2}["api/"]`,
+			wantType: BuildIntType(),
+		},
+		"when array is indexed": {
+			expr:     `["a", "b"][1]`,
+			wantType: BuildStringType(),
+		},
+		"when map key is not a string": {
+			expr:    `{1: 2}`,
+			wantErr: "type error at line 1, column 2: type inference failed: map keys must be strings",
+		},
+		"when map values have different types": {
+			expr:    `{"api/": 2, "web/": "1"}`,
+			wantErr: "type error at line 1, column 21: type inference failed: map values must have the same type",
+		},
+		"when map is indexed with an integer": {
+			expr:    `{"api/": 2}[0]`,
+			wantErr: "type error at line 1, column 13: type inference failed: map key must be a string",
+		},
+		"when array is indexed with a string": {
+			expr:    `[1, 2]["a"]`,
+			wantErr: "type error at line 1, column 8: type inference failed: array index must be an integer",
+		},
+		"when value is not an array nor a map": {
+			expr:    `1[0]`,
+			wantErr: "type error at line 1, column 2: type inference failed: index of a value that is not an array or a map",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(mockedTypeEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotType)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenTimestampsAndDurations(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	tests := map[string]struct {
+		expr     string
+		wantType Type
+		wantErr  string
+	}{
+		"when timestamps are subtracted": {
+			expr:     `2022-04-05 - 2022-04-01`,
+			wantType: BuildDurationType(),
+		},
+		"when duration is added to timestamp": {
+			expr:     `2022-04-05 + 2 days`,
+			wantType: BuildTimestampType(),
+		},
+		"when duration is subtracted from timestamp": {
+			expr:     `2022-04-05 - 2 days`,
+			wantType: BuildTimestampType(),
+		},
+		"when durations are added": {
+			expr:     `2 days + 3 hours`,
+			wantType: BuildDurationType(),
+		},
+		"when durations are compared": {
+			expr:     `2 days > 48 hours`,
+			wantType: BuildBoolType(),
+		},
+		"when timestamps are compared": {
+			expr:     `2022-04-05 < 2 days ago`,
+			wantType: BuildBoolType(),
+		},
+		"when timestamps are added": {
+			expr:    `2022-04-05 + 2022-04-01`,
+			wantErr: "type error at line 1, column 12: type inference failed",
+		},
+		"when timestamp is compared to duration": {
+			expr:    `2022-04-05 > 2 days`,
+			wantErr: "type error at line 1, column 12: type inference failed",
+		},
+		"when timestamp is compared to int": {
+			expr:     `2022-04-05 > 1650000000`,
+			wantType: BuildBoolType(),
+		},
+		"when timestamp is added to duration": {
+			expr:     `2 days + 2022-04-05`,
+			wantType: BuildTimestampType(),
+		},
+		"when duration is negated": {
+			expr:     `-(2 hours)`,
+			wantType: BuildDurationType(),
+		},
+		"when timestamp is negated": {
+			expr:    `-2022-04-05`,
+			wantErr: "type error at line 1, column 1: type inference failed",
+		},
+		"when duration is compared to int": {
+			expr:    `2 days > 10`,
+			wantErr: "type error at line 1, column 8: type inference failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(mockedTypeEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotType)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenMatchOperators(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	tests := map[string]struct {
+		expr     string
+		wantType Type
+		wantErr  string
+	}{
+		"when string matches regex": {
+			expr:     `"docs/README.md" =~ r"^docs/"`,
+			wantType: BuildBoolType(),
+		},
+		"when string does not match regex": {
+			expr:     `"docs/README.md" !~ r"^docs/"`,
+			wantType: BuildBoolType(),
+		},
+		"when regex is a string": {
+			expr:    `"docs/README.md" =~ "^docs/"`,
+			wantErr: "type error at line 1, column 18: type inference failed",
+		},
+		"when regex is invalid": {
+			expr:    `"docs/README.md" =~ r"^docs/("`,
+			wantErr: "type error at line 1, column 21: invalid regular expression \"^docs/(\": error parsing regexp: missing closing ): `^docs/(`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(mockedTypeEnv)
+
+			if test.wantErr != "" {
+				assert.Nil(t, gotType)
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestUnify_WhenTypeVariableOccursInType(t *testing.T) {
+	subst := make(substitution)
+	tVar := BuildTypeVariable("a")
+
+	assert.False(t, subst.unify(tVar, BuildArrayOfType(tVar)))
+}
+
+func TestUnify_WhenTypeVariablesAreBoundTransitively(t *testing.T) {
+	subst := make(substitution)
+
+	assert.True(t, subst.unify(BuildTypeVariable("a"), BuildTypeVariable("b")))
+	assert.True(t, subst.unify(BuildTypeVariable("b"), BuildIntType()))
+	assert.Equal(t, BuildArrayOfType(BuildIntType()), subst.apply(BuildArrayOfType(BuildTypeVariable("a"))))
+}
+
+func TestTypeInfer_WhenConditional(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		wantType Type
+		wantErr  string
+	}{
+		"when the branches have the same type": {
+			input:    `if $zeroConst() > 0 then "positive" else $returnStr("zero")`,
+			wantType: BuildStringType(),
+		},
+		"when the branches are arrays of different lengths": {
+			input:    `if true then ["a"] else ["b", "c"]`,
+			wantType: BuildArrayOfType(BuildStringType()),
+		},
+		"when the condition is not a boolean": {
+			input:   `if 1 then "a" else "b"`,
+			wantErr: "type error at line 1, column 4: type inference failed: condition of if expression is not a boolean",
+		},
+		"when the branches have different types": {
+			input:   `if true then "a" else 1`,
+			wantErr: "type error at line 1, column 1: type inference failed: branches of if expression have different types",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.input)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(MockTypeEnv())
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenLet(t *testing.T) {
+	typeEnv := MockTypeEnv()
+
+	expr, err := Parse(`let $greeting = $returnStr("hello") in $greeting + " world"`)
+	if err != nil {
+		assert.FailNow(t, "parse failed", err)
+	}
+
+	gotType, err := expr.typeinfer(typeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringType(), gotType)
+
+	// the variable is only visible in the body
+	_, ok := typeEnv["greeting"]
+	assert.False(t, ok)
+}
+
+func TestTypeInfer_WhenTry(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		wantType Type
+		wantErr  string
+	}{
+		"when the fallback has the same type": {
+			input:    `try($returnStr("a"), "b")`,
+			wantType: BuildStringType(),
+		},
+		"when the fallback is an array of a different length": {
+			input:    `try(["a", "b"], [])`,
+			wantType: BuildArrayOfType(BuildStringType()),
+		},
+		"when the fallback has a different type": {
+			input:   `try($zeroConst(), "zero")`,
+			wantErr: "type error at line 1, column 19: type inference failed: fallback of try expression has a different type",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.input)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(MockTypeEnv())
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantType, gotType)
+		})
+	}
+}
+
+func TestTypeInfer_WhenOptionalValuesAreCompared(t *testing.T) {
+	typeEnv := TypeEnv{
+		"none":      BuildFunctionType([]Type{}, BuildOptionalType(BuildTypeVariable("a"))),
+		"milestone": BuildFunctionType([]Type{}, BuildOptionalType(BuildStringType())),
+		"size":      BuildFunctionType([]Type{}, BuildOptionalType(BuildIntType())),
+	}
+
+	tests := map[string]struct {
+		input   string
+		wantErr string
+	}{
+		"when compared with a generic optional value": {
+			input: `$milestone() == $none()`,
+		},
+		"when compared with an optional value of another type": {
+			input:   `$milestone() == $size()`,
+			wantErr: "type error at line 1, column 14: type inference failed",
+		},
+		"when compared with a value of its element type": {
+			input: `$milestone() == "v1.0"`,
+		},
+		"when a value of its element type is compared with it": {
+			input: `1 != $size()`,
+		},
+		"when compared with a value of another type": {
+			input:   `$milestone() == 1`,
+			wantErr: "type error at line 1, column 14: type inference failed",
+		},
+		"when compared with an empty string": {
+			input:   `$milestone() == ""`,
+			wantErr: "type error at line 1, column 14: an optional value is never equal to \"\", use $isSet to check if it has a value",
+		},
+		"when an empty string is compared with it": {
+			input:   `"" != $milestone()`,
+			wantErr: "type error at line 1, column 4: an optional value is never equal to \"\", use $isSet to check if it has a value",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := Parse(test.input)
+			if err != nil {
+				assert.FailNow(t, "parse failed", err)
+			}
+
+			gotType, err := expr.typeinfer(typeEnv)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, BuildBoolType(), gotType)
+		})
+	}
+}
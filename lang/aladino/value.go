@@ -4,6 +4,8 @@
 
 package aladino
 
+import "regexp"
+
 type Value interface {
 	HasKindOf(string) bool
 	Kind() string
@@ -17,6 +19,11 @@ const (
 	TIME_VALUE     string = "TimeValue"
 	ARRAY_VALUE    string = "ArrayValue"
 	FUNCTION_VALUE string = "FunctionValue"
+	RECORD_VALUE   string = "RecordValue"
+	MAP_VALUE      string = "MapValue"
+	DURATION_VALUE string = "DurationValue"
+	REGEX_VALUE    string = "RegexValue"
+	OPTIONAL_VALUE string = "OptionalValue"
 )
 
 // IntValue represents an integer value
@@ -99,6 +106,7 @@ func (sVal *StringValue) HasKindOf(ty string) bool {
 	return sVal.Kind() == ty
 }
 
+// TimeValue represents a timestamp, in seconds since the Unix epoch
 type TimeValue struct {
 	Val int
 }
@@ -125,6 +133,56 @@ func (thisVal *TimeValue) Equals(other Value) bool {
 	return thisVal.Val == other.(*TimeValue).Val
 }
 
+// DurationValue represents a duration, in seconds
+type DurationValue struct {
+	Val int
+}
+
+func BuildDurationValue(dVal int) *DurationValue {
+	return &DurationValue{Val: dVal}
+}
+
+func (dVal *DurationValue) Kind() string {
+	return DURATION_VALUE
+}
+
+func (dVal *DurationValue) HasKindOf(kind string) bool {
+	return dVal.Kind() == kind
+}
+
+func (thisVal *DurationValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisVal.Val == other.(*DurationValue).Val
+}
+
+// RegexValue represents a compiled regular expression
+type RegexValue struct {
+	Val *regexp.Regexp
+}
+
+func BuildRegexValue(re *regexp.Regexp) *RegexValue {
+	return &RegexValue{Val: re}
+}
+
+func (rVal *RegexValue) Kind() string {
+	return REGEX_VALUE
+}
+
+func (rVal *RegexValue) HasKindOf(kind string) bool {
+	return rVal.Kind() == kind
+}
+
+func (thisVal *RegexValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisVal.Val.String() == other.(*RegexValue).Val.String()
+}
+
 // ArrayValue represents an array value
 type ArrayValue struct {
 	// defaultValue
@@ -167,10 +225,10 @@ func (aVal *ArrayValue) HasKindOf(ty string) bool {
 // FunctionValue represents a function value
 type FunctionValue struct {
 	// defaultValue
-	Fn func(args []Value) Value
+	Fn func(args []Value) (Value, error)
 }
 
-func BuildFunctionValue(fn func(args []Value) Value) *FunctionValue {
+func BuildFunctionValue(fn func(args []Value) (Value, error)) *FunctionValue {
 	return &FunctionValue{fn}
 }
 
@@ -186,3 +244,118 @@ func (thisVal *FunctionValue) Equals(other Value) bool {
 func (fVal *FunctionValue) HasKindOf(ty string) bool {
 	return fVal.Kind() == ty
 }
+
+// RecordValue represents a value made of named fields
+type RecordValue struct {
+	Vals map[string]Value
+}
+
+func BuildRecordValue(vals map[string]Value) *RecordValue {
+	return &RecordValue{vals}
+}
+
+func (rVal *RecordValue) Kind() string {
+	return RECORD_VALUE
+}
+
+func (thisVal *RecordValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	otherRecord := other.(*RecordValue)
+	if len(thisVal.Vals) != len(otherRecord.Vals) {
+		return false
+	}
+
+	for name, val := range thisVal.Vals {
+		otherVal, ok := otherRecord.Vals[name]
+		if !ok || !val.Equals(otherVal) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (rVal *RecordValue) HasKindOf(ty string) bool {
+	return rVal.Kind() == ty
+}
+
+// MapValue represents a map from strings to values
+type MapValue struct {
+	Vals map[string]Value
+}
+
+func BuildMapValue(vals map[string]Value) *MapValue {
+	return &MapValue{vals}
+}
+
+func (mVal *MapValue) Kind() string {
+	return MAP_VALUE
+}
+
+func (thisVal *MapValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	otherMap := other.(*MapValue)
+	if len(thisVal.Vals) != len(otherMap.Vals) {
+		return false
+	}
+
+	for key, val := range thisVal.Vals {
+		otherVal, ok := otherMap.Vals[key]
+		if !ok || !val.Equals(otherVal) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (mVal *MapValue) HasKindOf(ty string) bool {
+	return mVal.Kind() == ty
+}
+
+// OptionalValue represents a value that may be absent
+type OptionalValue struct {
+	// Val is nil when the value is absent.
+	Val Value
+}
+
+// BuildNoneValue builds an absent value.
+func BuildNoneValue() *OptionalValue {
+	return &OptionalValue{}
+}
+
+// BuildSomeValue builds a value that is present.
+func BuildSomeValue(val Value) *OptionalValue {
+	return &OptionalValue{val}
+}
+
+func (oVal *OptionalValue) IsSet() bool {
+	return oVal.Val != nil
+}
+
+func (oVal *OptionalValue) Kind() string {
+	return OPTIONAL_VALUE
+}
+
+func (thisVal *OptionalValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	otherOptional := other.(*OptionalValue)
+	if !thisVal.IsSet() || !otherOptional.IsSet() {
+		return thisVal.IsSet() == otherOptional.IsSet()
+	}
+
+	return thisVal.Val.Equals(otherOptional.Val)
+}
+
+func (oVal *OptionalValue) HasKindOf(ty string) bool {
+	return oVal.Kind() == ty
+}
@@ -5,6 +5,7 @@
 package aladino_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
@@ -73,8 +74,8 @@ func TestBuildArrayValue(t *testing.T) {
 }
 
 func TestBuildFunctionValue(t *testing.T) {
-	fn := func(args []aladino.Value) aladino.Value {
-		return &aladino.IntValue{Val: 0}
+	fn := func(args []aladino.Value) (aladino.Value, error) {
+		return &aladino.IntValue{Val: 0}, nil
 	}
 
 	wantVal := &aladino.FunctionValue{fn}
@@ -135,8 +136,8 @@ func TestFunctionValueKind(t *testing.T) {
 	wantVal := aladino.FUNCTION_VALUE
 
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 	gotVal := fnVal.Kind()
@@ -178,8 +179,8 @@ func TestArrayValueHasKindOf(t *testing.T) {
 
 func TestFunctionValueHasKindOf(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
@@ -309,14 +310,14 @@ func TestArrayValueEquals_WhenFalse(t *testing.T) {
 
 func TestFunctionValueEquals_WhenTrue(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
 	otherVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
@@ -325,8 +326,8 @@ func TestFunctionValueEquals_WhenTrue(t *testing.T) {
 
 func TestFunctionValueEquals_WhenFalse(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
@@ -334,3 +335,108 @@ func TestFunctionValueEquals_WhenFalse(t *testing.T) {
 
 	assert.False(t, fnVal.Equals(otherVal))
 }
+
+func TestRecordValueEquals_WhenTrue(t *testing.T) {
+	recordVal := aladino.BuildRecordValue(map[string]aladino.Value{"user": aladino.BuildStringValue("john")})
+	otherVal := aladino.BuildRecordValue(map[string]aladino.Value{"user": aladino.BuildStringValue("john")})
+
+	assert.True(t, recordVal.Equals(otherVal))
+}
+
+func TestRecordValueEquals_WhenFieldsDiffer(t *testing.T) {
+	recordVal := aladino.BuildRecordValue(map[string]aladino.Value{"user": aladino.BuildStringValue("john")})
+	otherVal := aladino.BuildRecordValue(map[string]aladino.Value{"user": aladino.BuildStringValue("jane")})
+
+	assert.False(t, recordVal.Equals(otherVal))
+}
+
+func TestRecordValueEquals_WhenFalse(t *testing.T) {
+	recordVal := aladino.BuildRecordValue(map[string]aladino.Value{"user": aladino.BuildStringValue("john")})
+	otherVal := aladino.BuildStringValue("john")
+
+	assert.False(t, recordVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenTrue(t *testing.T) {
+	mapVal := aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)})
+	otherVal := aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)})
+
+	assert.True(t, mapVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenKeysDiffer(t *testing.T) {
+	mapVal := aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)})
+	otherVal := aladino.BuildMapValue(map[string]aladino.Value{"web/": aladino.BuildIntValue(2)})
+
+	assert.False(t, mapVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenFalse(t *testing.T) {
+	mapVal := aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)})
+	otherVal := aladino.BuildIntValue(2)
+
+	assert.False(t, mapVal.Equals(otherVal))
+}
+
+func TestDurationValueEquals_WhenTrue(t *testing.T) {
+	durationVal := aladino.BuildDurationValue(60)
+	otherVal := aladino.BuildDurationValue(60)
+
+	assert.True(t, durationVal.Equals(otherVal))
+}
+
+func TestDurationValueEquals_WhenDiffKinds(t *testing.T) {
+	durationVal := aladino.BuildDurationValue(60)
+	otherVal := aladino.BuildTimeValue(60)
+
+	assert.False(t, durationVal.Equals(otherVal))
+}
+
+func TestRegexValueEquals_WhenTrue(t *testing.T) {
+	regexVal := aladino.BuildRegexValue(regexp.MustCompile("^docs/"))
+	otherVal := aladino.BuildRegexValue(regexp.MustCompile("^docs/"))
+
+	assert.True(t, regexVal.Equals(otherVal))
+}
+
+func TestRegexValueEquals_WhenFalse(t *testing.T) {
+	regexVal := aladino.BuildRegexValue(regexp.MustCompile("^docs/"))
+	otherVal := aladino.BuildStringValue("^docs/")
+
+	assert.False(t, regexVal.Equals(otherVal))
+}
+
+func TestOptionalValueEquals(t *testing.T) {
+	tests := map[string]struct {
+		value     aladino.Value
+		other     aladino.Value
+		wantEqual bool
+	}{
+		"when both are absent": {
+			value:     aladino.BuildNoneValue(),
+			other:     aladino.BuildNoneValue(),
+			wantEqual: true,
+		},
+		"when only one is absent": {
+			value:     aladino.BuildNoneValue(),
+			other:     aladino.BuildSomeValue(aladino.BuildStringValue("")),
+			wantEqual: false,
+		},
+		"when both hold the same value": {
+			value:     aladino.BuildSomeValue(aladino.BuildStringValue("v1.0")),
+			other:     aladino.BuildSomeValue(aladino.BuildStringValue("v1.0")),
+			wantEqual: true,
+		},
+		"when other is not optional": {
+			value:     aladino.BuildSomeValue(aladino.BuildStringValue("v1.0")),
+			other:     aladino.BuildStringValue("v1.0"),
+			wantEqual: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantEqual, test.value.Equals(test.other))
+		})
+	}
+}
@@ -13,30 +13,37 @@ import (
 
 func AddLabel() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: addLabelCode,
+		Type:        aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType(), nil).WithParamNames("label"),
+		Code:        addLabelCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
 func addLabelCode(e aladino.Env, args []aladino.Value) error {
-	labelID := args[0].(*aladino.StringValue).Val
+	labelIDs := []string{args[0].(*aladino.StringValue).Val}
+	if len(args) > 1 {
+		for _, labelID := range args[1].(*aladino.ArrayValue).Vals {
+			labelIDs = append(labelIDs, labelID.(*aladino.StringValue).Val)
+		}
+	}
 
 	prNum := gh.GetPullRequestNumber(e.GetPullRequest())
 	owner := gh.GetPullRequestBaseOwnerName(e.GetPullRequest())
 	repo := gh.GetPullRequestBaseRepoName(e.GetPullRequest())
 
-	internalLabelID := aladino.BuildInternalLabelID(labelID)
-
-	var labelName string
+	labelNames := make([]string, len(labelIDs))
+	for i, labelID := range labelIDs {
+		internalLabelID := aladino.BuildInternalLabelID(labelID)
 
-	if val, ok := e.GetRegisterMap()[internalLabelID]; ok {
-		labelName = val.(*aladino.StringValue).Val
-	} else {
-		labelName = labelID
-		log.Printf("[warn]: the %v label was not found in the environment", labelID)
+		if val, ok := e.GetRegisterMap()[internalLabelID]; ok {
+			labelNames[i] = val.(*aladino.StringValue).Val
+		} else {
+			labelNames[i] = labelID
+			log.Printf("[warn]: the %v label was not found in the environment", labelID)
+		}
 	}
 
-	_, _, err := e.GetGithubClient().AddLabels(e.GetCtx(), owner, repo, prNum, []string{labelName})
+	_, _, err := e.GetGithubClient().AddLabels(e.GetCtx(), owner, repo, prNum, labelNames)
 
 	return err
 }
@@ -128,3 +128,36 @@ func TestAddLabel_WhenLabelIsNotInEnvironment(t *testing.T) {
 	assert.Nil(t, err)
 	assert.ElementsMatch(t, wantLabels, gotLabels)
 }
+
+func TestAddLabel_WhenManyLabelsAreGiven(t *testing.T) {
+	wantLabels := []string{"bug", "critical"}
+	gotLabels := []string{}
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := ioutil.ReadAll(r.Body)
+					body := []string{}
+
+					json.Unmarshal(rawBody, &body)
+
+					gotLabels = body
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []aladino.Value{
+		aladino.BuildStringValue("bug"),
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("critical")}),
+	}
+	err := addLabel(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantLabels, gotLabels)
+}
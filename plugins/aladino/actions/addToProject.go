@@ -38,8 +38,9 @@ type UpdateProjectV2ItemFieldValueInput struct {
 
 func AddToProject() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildStringType()}, aladino.BuildStringType()),
-		Code: addToProjectCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildStringType()}, aladino.BuildStringType()).WithParamNames("project", "status"),
+		Code:        addToProjectCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
@@ -13,8 +13,9 @@ import (
 
 func AssignAssignees() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
-		Code: assignAssigneesCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil).WithParamNames("assignees"),
+		Code:        assignAssigneesCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
@@ -15,8 +15,9 @@ import (
 
 func AssignRandomReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, nil),
-		Code: assignRandomReviewerCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{}, nil),
+		Code:        assignRandomReviewerCode,
+		Invalidates: []string{"isWaitingForReview", "lastEventAt", "reviewers"},
 	}
 }
 
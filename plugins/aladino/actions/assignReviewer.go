@@ -16,8 +16,14 @@ import (
 
 func AssignReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildIntType()}, nil),
-		Code: assignReviewerCode,
+		Type: aladino.BuildFunctionTypeWithDefaults(
+			[]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildIntType()},
+			// By default, all the available reviewers are assigned.
+			[]aladino.Value{aladino.BuildIntValue(99)},
+			nil,
+		).WithParamNames("reviewers", "total"),
+		Code:        assignReviewerCode,
+		Invalidates: []string{"isWaitingForReview", "lastEventAt", "reviewers"},
 	}
 }
 
@@ -54,7 +60,7 @@ func assignReviewerCode(e aladino.Env, args []aladino.Value) error {
 
 	reviewers := []string{}
 
-	reviews, err := e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, prNum)
+	reviews, err := aladino.GetPullRequestReviews(e)
 	if err != nil {
 		return err
 	}
@@ -14,8 +14,9 @@ import (
 
 func AssignTeamReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
-		Code: assignTeamReviewerCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil).WithParamNames("teams"),
+		Code:        assignTeamReviewerCode,
+		Invalidates: []string{"isWaitingForReview", "lastEventAt", "reviewers"},
 	}
 }
 
@@ -11,8 +11,9 @@ import (
 
 func Close() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, nil),
-		Code: closeCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{}, nil),
+		Code:        closeCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
@@ -12,8 +12,9 @@ import (
 
 func Comment() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: commentCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil).WithParamNames("comment"),
+		Code:        commentCode,
+		Invalidates: []string{"comments", "lastEventAt"},
 	}
 }
 
@@ -17,8 +17,9 @@ const ReviewpadCommentAnnotation = "<!--@annotation-reviewpad-single-comment-->"
 
 func CommentOnce() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: commentOnceCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil).WithParamNames("comment"),
+		Code:        commentOnceCode,
+		Invalidates: []string{"comments", "lastEventAt"},
 	}
 }
 
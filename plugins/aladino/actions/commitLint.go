@@ -9,7 +9,6 @@ import (
 
 	"github.com/reviewpad/go-conventionalcommits"
 	"github.com/reviewpad/go-conventionalcommits/parser"
-	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 )
 
@@ -21,11 +20,7 @@ func CommitLint() *aladino.BuiltInAction {
 }
 
 func commitLintCode(e aladino.Env, _ []aladino.Value) error {
-	prNum := gh.GetPullRequestNumber(e.GetPullRequest())
-	owner := gh.GetPullRequestBaseOwnerName(e.GetPullRequest())
-	repo := gh.GetPullRequestBaseRepoName(e.GetPullRequest())
-
-	ghCommits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, prNum)
+	ghCommits, err := aladino.GetPullRequestCommits(e)
 	if err != nil {
 		return err
 	}
@@ -10,7 +10,7 @@ import (
 
 func DisableActions() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil).WithParamNames("actions"),
 		Code: disableActionsCode,
 	}
 }
@@ -10,7 +10,7 @@ import (
 
 func Fail() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil).WithParamNames("message"),
 		Code: failCode,
 	}
 }
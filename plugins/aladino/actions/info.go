@@ -8,7 +8,7 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func Info() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil).WithParamNames("message"),
 		Code: infoCode,
 	}
 }
@@ -14,8 +14,9 @@ import (
 
 func Merge() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: mergeCode,
+		Type:        aladino.BuildFunctionTypeWithDefaults([]aladino.Type{aladino.BuildStringType()}, []aladino.Value{aladino.BuildStringValue("merge")}, nil).WithParamNames("method"),
+		Code:        mergeCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
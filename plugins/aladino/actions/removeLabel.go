@@ -13,8 +13,9 @@ import (
 
 func RemoveLabel() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: removeLabelCode,
+		Type:        aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil).WithParamNames("label"),
+		Code:        removeLabelCode,
+		Invalidates: []string{"lastEventAt"},
 	}
 }
 
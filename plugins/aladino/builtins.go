@@ -60,10 +60,12 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"commentCount":          functions.CommentCount(),
 			"comments":              functions.Comments(),
 			"commitCount":           functions.CommitCount(),
+			"commitDetails":         functions.CommitDetails(),
 			"commits":               functions.Commits(),
 			"createdAt":             functions.CreatedAt(),
 			"description":           functions.Description(),
 			"fileCount":             functions.FileCount(),
+			"files":                 functions.Files(),
 			"hasAnnotation":         functions.HasAnnotation(),
 			"hasCodePattern":        functions.HasCodePattern(),
 			"hasFileExtensions":     functions.HasFileExtensions(),
@@ -80,6 +82,7 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"milestone":             functions.Milestone(),
 			"reviewers":             functions.Reviewers(),
 			"reviewerStatus":        functions.ReviewerStatus(),
+			"reviews":               functions.Reviews(),
 			"size":                  functions.Size(),
 			"title":                 functions.Title(),
 			"workflowStatus":        functions.WorkflowStatus(),
@@ -89,12 +92,31 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			// User
 			"totalCreatedPullRequests": functions.TotalCreatedPullRequests(),
 			// Utilities
-			"append":      functions.AppendString(),
-			"contains":    functions.Contains(),
-			"isElementOf": functions.IsElementOf(),
-			"startsWith":  functions.StartsWith(),
-			"length":      functions.Length(),
-			"sprintf":     functions.Sprintf(),
+			"all":          functions.All(),
+			"any":          functions.Any(),
+			"append":       functions.AppendString(),
+			"contains":     functions.Contains(),
+			"count":        functions.Count(),
+			"getOrDefault": functions.GetOrDefault(),
+			"isElementOf":  functions.IsElementOf(),
+			"keys":         functions.Keys(),
+			"map":          functions.Map(),
+			"matchGroups":  functions.MatchGroups(),
+			"reduce":       functions.Reduce(),
+			"startsWith":   functions.StartsWith(),
+			"length":       functions.Length(),
+			"sprintf":      functions.Sprintf(),
+			"values":       functions.Values(),
+			// Optional
+			"isSet":  functions.IsSet(),
+			"none":   functions.None(),
+			"orElse": functions.OrElse(),
+			"some":   functions.Some(),
+			// Time
+			"durationSince": functions.DurationSince(),
+			"hourOf":        functions.HourOf(),
+			"now":           functions.Now(),
+			"weekday":       functions.Weekday(),
 			// Engine
 			"group": functions.Group(),
 			"rule":  functions.Rule(),
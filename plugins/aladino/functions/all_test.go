@@ -0,0 +1,59 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var all = plugins_aladino.PluginBuiltIns().Functions["all"].Code
+
+func TestAll(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	isDocs := aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+		return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "docs"), nil
+	})
+
+	tests := map[string]struct {
+		elems   []aladino.Value
+		wantVal aladino.Value
+	}{
+		"when all elements match": {
+			elems:   []aladino.Value{aladino.BuildStringValue("docs"), aladino.BuildStringValue("docs")},
+			wantVal: aladino.BuildTrueValue(),
+		},
+		"when some element does not match": {
+			elems:   []aladino.Value{aladino.BuildStringValue("docs"), aladino.BuildStringValue("src")},
+			wantVal: aladino.BuildFalseValue(),
+		},
+		"when there are no elements": {
+			elems:   []aladino.Value{},
+			wantVal: aladino.BuildTrueValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotVal, err := all(mockedEnv, []aladino.Value{aladino.BuildArrayValue(test.elems), isDocs})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestAll_WhenWrittenWithLambda(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$all(["docs/a.md", "docs/b.md"], ($file: String => $startsWith($file, "docs/")))`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
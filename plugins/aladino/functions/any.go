@@ -0,0 +1,42 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Any() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// ([]a, (a) => Bool) => Bool
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+				aladino.BuildFunctionType(
+					[]aladino.Type{aladino.BuildTypeVariable("a")},
+					aladino.BuildBoolType(),
+				),
+			},
+			aladino.BuildBoolType(),
+		).WithParamNames("list", "predicate"),
+		Code: anyCode,
+	}
+}
+
+func anyCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	elems := args[0].(*aladino.ArrayValue).Vals
+	fn := args[1].(*aladino.FunctionValue).Fn
+
+	for _, elem := range elems {
+		fnResult, err := fn([]aladino.Value{elem})
+		if err != nil {
+			return nil, err
+		}
+
+		if fnResult.(*aladino.BoolValue).Val {
+			return aladino.BuildTrueValue(), nil
+		}
+	}
+
+	return aladino.BuildFalseValue(), nil
+}
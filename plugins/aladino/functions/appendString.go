@@ -8,7 +8,7 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func AppendString() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildArrayOfType(aladino.BuildStringType())),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildArrayOfType(aladino.BuildStringType())).WithParamNames("first", "second"),
 		Code: appendStringCode,
 	}
 }
@@ -5,6 +5,7 @@
 package plugins_aladino_functions
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -13,8 +14,15 @@ import (
 
 func Changed() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("antecedent", "consequent"),
 		Code: changedCode,
+		ValidateArg: func(_ int, pattern string) error {
+			resolvedPattern, _ := interpolateRegex(pattern)
+			if _, err := regexp.Compile(resolvedPattern); err != nil {
+				return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+			}
+			return nil
+		},
 	}
 }
 
@@ -22,8 +30,15 @@ func changedCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 	antecedentRegex := args[0].(*aladino.StringValue).Val
 	consequentRegex := args[1].(*aladino.StringValue).Val
 
-	antecedentMatches := getMatches(e, antecedentRegex)
-	consequentMatches := getMatches(e, consequentRegex)
+	antecedentMatches, err := getMatches(e, antecedentRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	consequentMatches, err := getMatches(e, consequentRegex)
+	if err != nil {
+		return nil, err
+	}
 
 	retValue := aladino.BuildTrueValue()
 
@@ -50,14 +65,22 @@ func changedCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 	return retValue, nil
 }
 
-func getMatches(env aladino.Env, pattern string) map[string][]string {
+func getMatches(env aladino.Env, pattern string) (map[string][]string, error) {
 	resolvedPattern, vars := interpolateRegex(pattern)
-	re := regexp.MustCompile(resolvedPattern)
+	re, err := regexp.Compile(resolvedPattern)
+	if err != nil {
+		return nil, fmt.Errorf("changed: invalid pattern %q: %v", pattern, err)
+	}
 
 	valsMatrix := make(map[string][]string, 0)
 
 	for fp := range env.GetPatch() {
 		for idx, ranges := range re.FindAllStringSubmatchIndex(fp, -1) {
+			// patterns without variables have no groups to capture
+			if idx >= len(vars) || len(ranges) < 4 || ranges[2] < 0 {
+				continue
+			}
+
 			lower := ranges[2]
 			upper := ranges[3]
 			varName := vars[idx]
@@ -71,7 +94,7 @@ func getMatches(env aladino.Env, pattern string) map[string][]string {
 		}
 	}
 
-	return valsMatrix
+	return valsMatrix, nil
 }
 
 func interpolateRegex(s string) (string, []string) {
@@ -38,6 +38,10 @@ func TestChanged(t *testing.T) {
 			args:    []aladino.Value{aladino.BuildStringValue("src/@1/@2.go"), aladino.BuildStringValue("src/@1/@2_test.go")},
 			wantVal: aladino.BuildTrueValue(),
 		},
+		"pattern without variables": {
+			args:    []aladino.Value{aladino.BuildStringValue("src/file.go"), aladino.BuildStringValue("docs/main.md")},
+			wantVal: aladino.BuildTrueValue(),
+		},
 		"nested patterns": {
 			args:    []aladino.Value{aladino.BuildStringValue("src/pkg/@1.go"), aladino.BuildStringValue("src/pkg/dir/@2.go")},
 			wantVal: aladino.BuildFalseValue(),
@@ -86,3 +90,20 @@ func TestChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestChanged_WhenPatternIsInvalid(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{aladino.BuildStringValue("src/(@1.go"), aladino.BuildStringValue("docs/@1.md")}
+	gotVal, err := changed(mockedEnv, args)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "changed: invalid pattern \"src/(@1.go\": error parsing regexp: missing closing ): `src/((.*).go`")
+}
+
+func TestChanged_WhenPatternIsValidated(t *testing.T) {
+	validateArg := plugins_aladino.PluginBuiltIns().Functions["changed"].ValidateArg
+
+	assert.NoError(t, validateArg(0, "src/@1.go"))
+	assert.EqualError(t, validateArg(1, "src/(@1.go"), "invalid pattern \"src/(@1.go\": error parsing regexp: missing closing ): `src/((.*).go`")
+}
@@ -14,6 +14,8 @@ func Comments() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: commentsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
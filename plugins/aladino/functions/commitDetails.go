@@ -0,0 +1,41 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func CommitDetails() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildCommitType())),
+		Code: commitDetailsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
+	}
+}
+
+func commitDetailsCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
+	ghCommits, err := aladino.GetPullRequestCommits(e)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]aladino.Value, len(ghCommits))
+	for i, ghCommit := range ghCommits {
+		// the author is the GitHub user when the commit is linked to one
+		author := ghCommit.GetAuthor().GetLogin()
+		if author == "" {
+			author = ghCommit.Commit.GetAuthor().GetName()
+		}
+
+		commits[i] = aladino.BuildRecordValue(map[string]aladino.Value{
+			"sha":     aladino.BuildStringValue(ghCommit.GetSHA()),
+			"author":  aladino.BuildStringValue(author),
+			"message": aladino.BuildStringValue(ghCommit.Commit.GetMessage()),
+			"date":    aladino.BuildTimeValue(int(ghCommit.Commit.GetAuthor().GetDate().Unix())),
+		})
+	}
+
+	return aladino.BuildArrayValue(commits), nil
+}
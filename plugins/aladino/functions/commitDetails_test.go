@@ -0,0 +1,135 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var commitDetails = plugins_aladino.PluginBuiltIns().Functions["commitDetails"].Code
+
+func TestCommitDetails_WhenListCommitsRequestFails(t *testing.T) {
+	failMessage := "ListCommitsRequestFail"
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(
+						w,
+						http.StatusInternalServerError,
+						failMessage,
+					)
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []aladino.Value{}
+	gotCommits, err := commitDetails(mockedEnv, args)
+
+	assert.Nil(t, gotCommits)
+	assert.Equal(t, err.(*github.ErrorResponse).Message, failMessage)
+}
+
+func TestCommitDetails(t *testing.T) {
+	date := time.Date(2022, 10, 3, 12, 0, 0, 0, time.UTC)
+	repoCommits := []*github.RepositoryCommit{
+		{
+			SHA:    github.String("1a2b3c"),
+			Author: &github.User{Login: github.String("john")},
+			Commit: &github.Commit{
+				Message: github.String("Lorem Ipsum"),
+				Author: &github.CommitAuthor{
+					Name: github.String("John Doe"),
+					Date: &date,
+				},
+			},
+		},
+		{
+			SHA: github.String("4d5e6f"),
+			Commit: &github.Commit{
+				Message: github.String("Dolor sit amet"),
+				Author: &github.CommitAuthor{
+					Name: github.String("Jane Doe"),
+					Date: &date,
+				},
+			},
+		},
+	}
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				repoCommits,
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	wantCommits := aladino.BuildArrayValue([]aladino.Value{
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"sha":     aladino.BuildStringValue("1a2b3c"),
+			"author":  aladino.BuildStringValue("john"),
+			"message": aladino.BuildStringValue("Lorem Ipsum"),
+			"date":    aladino.BuildTimeValue(int(date.Unix())),
+		}),
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"sha":     aladino.BuildStringValue("4d5e6f"),
+			"author":  aladino.BuildStringValue("Jane Doe"),
+			"message": aladino.BuildStringValue("Dolor sit amet"),
+			"date":    aladino.BuildTimeValue(int(date.Unix())),
+		}),
+	})
+
+	args := []aladino.Value{}
+	gotCommits, err := commitDetails(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantCommits, gotCommits)
+}
+
+func TestCommitDetails_WhenAccessingFields(t *testing.T) {
+	repoCommits := []*github.RepositoryCommit{
+		{
+			Author: &github.User{Login: github.String("dependabot")},
+			Commit: &github.Commit{
+				Message: github.String("Bump version"),
+			},
+		},
+	}
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				repoCommits,
+			),
+		},
+		nil,
+		plugins_aladino.PluginBuiltIns(),
+		nil,
+	)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$all($commitDetails(), ($c: Commit => $c.author == "dependabot"))`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -4,25 +4,19 @@
 
 package plugins_aladino_functions
 
-import (
-	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
-	"github.com/reviewpad/reviewpad/v3/lang/aladino"
-)
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func Commits() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: commitsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
 func commitsCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
-	pullRequest := e.GetPullRequest()
-	prNum := gh.GetPullRequestNumber(pullRequest)
-	owner := gh.GetPullRequestBaseOwnerName(pullRequest)
-	repo := gh.GetPullRequestBaseRepoName(pullRequest)
-
-	ghCommits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, prNum)
+	ghCommits, err := aladino.GetPullRequestCommits(e)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Count() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// ([]a, (a) => Bool) => Int
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+				aladino.BuildFunctionType(
+					[]aladino.Type{aladino.BuildTypeVariable("a")},
+					aladino.BuildBoolType(),
+				),
+			},
+			aladino.BuildIntType(),
+		).WithParamNames("list", "predicate"),
+		Code: countCode,
+	}
+}
+
+func countCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	elems := args[0].(*aladino.ArrayValue).Vals
+	fn := args[1].(*aladino.FunctionValue).Fn
+
+	count := 0
+	for _, elem := range elems {
+		fnResult, err := fn([]aladino.Value{elem})
+		if err != nil {
+			return nil, err
+		}
+
+		if fnResult.(*aladino.BoolValue).Val {
+			count++
+		}
+	}
+
+	return aladino.BuildIntValue(count), nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var count = plugins_aladino.PluginBuiltIns().Functions["count"].Code
+
+func TestCount(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("docs"), aladino.BuildStringValue("src"), aladino.BuildStringValue("docs")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "docs"), nil
+		}),
+	}
+	gotVal, err := count(mockedEnv, args)
+
+	wantVal := aladino.BuildIntValue(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
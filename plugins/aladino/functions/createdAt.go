@@ -10,11 +10,11 @@ import (
 
 func CreatedAt() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildIntType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildTimestampType()),
 		Code: createdAtCode,
 	}
 }
 
 func createdAtCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
-	return aladino.BuildIntValue(int(e.GetPullRequest().GetCreatedAt().Unix())), nil
+	return aladino.BuildTimeValue(int(e.GetPullRequest().GetCreatedAt().Unix())), nil
 }
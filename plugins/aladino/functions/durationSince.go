@@ -0,0 +1,24 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func DurationSince() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildTimestampType()}, aladino.BuildDurationType()).WithParamNames("time"),
+		Code: durationSinceCode,
+	}
+}
+
+func durationSinceCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	timestamp := args[0].(*aladino.TimeValue).Val
+
+	return aladino.BuildDurationValue(int(time.Now().Unix()) - timestamp), nil
+}
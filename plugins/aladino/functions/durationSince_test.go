@@ -0,0 +1,37 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var durationSince = plugins_aladino.PluginBuiltIns().Functions["durationSince"].Code
+
+func TestDurationSince(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	twoHoursAgo := int(time.Now().Add(-2 * time.Hour).Unix())
+
+	args := []aladino.Value{aladino.BuildTimeValue(twoHoursAgo)}
+	gotDuration, err := durationSince(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.InDelta(t, 2*60*60, gotDuration.(*aladino.DurationValue).Val, 1)
+}
+
+func TestDurationSince_WhenComparedToDuration(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$durationSince(3 days ago) > 48 hours`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
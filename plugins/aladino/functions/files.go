@@ -0,0 +1,43 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"sort"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Files() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildFileType())),
+		Code: filesCode,
+	}
+}
+
+func filesCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
+	patch := e.GetPatch()
+
+	// the patch is a map so the files are sorted to keep the result stable
+	paths := make([]string, 0, len(patch))
+	for path := range patch {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	files := make([]aladino.Value, len(paths))
+	for i, path := range paths {
+		ghFile := patch[path].Repr
+
+		files[i] = aladino.BuildRecordValue(map[string]aladino.Value{
+			"path":      aladino.BuildStringValue(path),
+			"additions": aladino.BuildIntValue(ghFile.GetAdditions()),
+			"deletions": aladino.BuildIntValue(ghFile.GetDeletions()),
+			"status":    aladino.BuildStringValue(ghFile.GetStatus()),
+		})
+	}
+
+	return aladino.BuildArrayValue(files), nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var files = plugins_aladino.PluginBuiltIns().Functions["files"].Code
+
+func TestFiles(t *testing.T) {
+	mockedPullRequestFileList := &[]*github.CommitFile{
+		{
+			Filename:  github.String("src/main.go"),
+			Additions: github.Int(10),
+			Deletions: github.Int(2),
+			Status:    github.String("modified"),
+		},
+		{
+			Filename:  github.String("README.md"),
+			Additions: github.Int(3),
+			Deletions: github.Int(0),
+			Status:    github.String("added"),
+		},
+	}
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Write(mock.MustMarshal(mockedPullRequestFileList))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	wantFiles := aladino.BuildArrayValue([]aladino.Value{
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"path":      aladino.BuildStringValue("README.md"),
+			"additions": aladino.BuildIntValue(3),
+			"deletions": aladino.BuildIntValue(0),
+			"status":    aladino.BuildStringValue("added"),
+		}),
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"path":      aladino.BuildStringValue("src/main.go"),
+			"additions": aladino.BuildIntValue(10),
+			"deletions": aladino.BuildIntValue(2),
+			"status":    aladino.BuildStringValue("modified"),
+		}),
+	})
+
+	args := []aladino.Value{}
+	gotFiles, err := files(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantFiles, gotFiles)
+}
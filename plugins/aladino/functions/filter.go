@@ -8,16 +8,17 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func Filter() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
+		// ([]a, (a) => Bool) => []a
 		Type: aladino.BuildFunctionType(
 			[]aladino.Type{
-				aladino.BuildArrayOfType(aladino.BuildStringType()),
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
 				aladino.BuildFunctionType(
-					[]aladino.Type{aladino.BuildStringType()},
+					[]aladino.Type{aladino.BuildTypeVariable("a")},
 					aladino.BuildBoolType(),
 				),
 			},
-			aladino.BuildArrayOfType(aladino.BuildStringType()),
-		),
+			aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+		).WithParamNames("list", "predicate"),
 		Code: filterCode,
 	}
 }
@@ -28,8 +29,12 @@ func filterCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 	fn := args[1].(*aladino.FunctionValue).Fn
 
 	for _, elem := range elems {
-		fnResult := fn([]aladino.Value{elem}).(*aladino.BoolValue).Val
-		if fnResult {
+		fnResult, err := fn([]aladino.Value{elem})
+		if err != nil {
+			return nil, err
+		}
+
+		if fnResult.(*aladino.BoolValue).Val {
 			result = append(result, elem)
 		}
 	}
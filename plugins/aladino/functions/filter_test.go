@@ -20,8 +20,8 @@ func TestFilter(t *testing.T) {
 
 	args := []aladino.Value{
 		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("1"), mockedIntValue}),
-		aladino.BuildFunctionValue(func(args []aladino.Value) aladino.Value {
-			return aladino.BuildBoolValue(args[0].HasKindOf(aladino.INT_VALUE))
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].HasKindOf(aladino.INT_VALUE)), nil
 		}),
 	}
 	gotElems, err := filter(mockedEnv, args)
@@ -0,0 +1,34 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func GetOrDefault() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (map[String]a, String, a) => a
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildMapType(aladino.BuildTypeVariable("a")),
+				aladino.BuildStringType(),
+				aladino.BuildTypeVariable("a"),
+			},
+			aladino.BuildTypeVariable("a"),
+		).WithParamNames("map", "key", "default"),
+		Code: getOrDefaultCode,
+	}
+}
+
+func getOrDefaultCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	vals := args[0].(*aladino.MapValue).Vals
+	key := args[1].(*aladino.StringValue).Val
+	defaultValue := args[2]
+
+	if val, ok := vals[key]; ok {
+		return val, nil
+	}
+
+	return defaultValue, nil
+}
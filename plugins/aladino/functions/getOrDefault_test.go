@@ -0,0 +1,52 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var getOrDefault = plugins_aladino.PluginBuiltIns().Functions["getOrDefault"].Code
+
+func TestGetOrDefault_WhenKeyExists(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)}),
+		aladino.BuildStringValue("api/"),
+		aladino.BuildIntValue(1),
+	}
+	gotVal, err := getOrDefault(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(2), gotVal)
+}
+
+func TestGetOrDefault_WhenKeyDoesNotExist(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{"api/": aladino.BuildIntValue(2)}),
+		aladino.BuildStringValue("web/"),
+		aladino.BuildIntValue(1),
+	}
+	gotVal, err := getOrDefault(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(1), gotVal)
+}
+
+func TestGetOrDefault_WhenMapIsLiteral(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$getOrDefault({"api/": 2, "web/": 1}, "docs/", 0) == 0`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -17,8 +17,10 @@ import (
 
 func HasAnnotation() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("annotation"),
 		Code: hasAnnotationCode,
+		Cost: aladino.COST_HIGH,
+		Pure: true,
 	}
 }
 
@@ -4,12 +4,23 @@
 
 package plugins_aladino_functions
 
-import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
 
 func HasCodePattern() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("pattern"),
 		Code: hasCodePatternCode,
+		ValidateArg: func(_ int, pattern string) error {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+			}
+			return nil
+		},
 	}
 }
 
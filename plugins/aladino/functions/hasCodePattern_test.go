@@ -59,6 +59,13 @@ func TestHasCodePattern_WhenPatternIsInvalid(t *testing.T) {
 	assert.EqualError(t, err, "query: compile error error parsing regexp: missing closing ): `a(`")
 }
 
+func TestHasCodePattern_WhenPatternIsValidated(t *testing.T) {
+	validateArg := plugins_aladino.PluginBuiltIns().Functions["hasCodePattern"].ValidateArg
+
+	assert.NoError(t, validateArg(0, "placeBet\\(.*\\)"))
+	assert.EqualError(t, validateArg(0, "a("), "invalid pattern \"a(\": error parsing regexp: missing closing ): `a(`")
+}
+
 func TestHasCodePattern(t *testing.T) {
 	mockedPullRequestFileList := &[]*github.CommitFile{{
 		Patch:    github.String("@@ -2,9 +2,11 @@ package main\n- func previous() {\n+ func new() {\n+\nreturn"),
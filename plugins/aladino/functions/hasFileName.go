@@ -8,7 +8,7 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func HasFileName() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("name"),
 		Code: hasFileNameCode,
 	}
 }
@@ -5,14 +5,22 @@
 package plugins_aladino_functions
 
 import (
+	"fmt"
+
 	doublestar "github.com/bmatcuk/doublestar/v4"
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 )
 
 func HasFilePattern() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("pattern"),
 		Code: hasFilePatternCode,
+		ValidateArg: func(_ int, pattern string) error {
+			if !doublestar.ValidatePattern(pattern) {
+				return fmt.Errorf("invalid pattern %q", pattern)
+			}
+			return nil
+		},
 	}
 }
 
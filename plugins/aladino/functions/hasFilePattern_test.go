@@ -29,6 +29,13 @@ func TestHasFilePattern_WhenFileBadPattern(t *testing.T) {
 	assert.EqualError(t, err, "syntax error in pattern")
 }
 
+func TestHasFilePattern_WhenPatternIsValidated(t *testing.T) {
+	validateArg := plugins_aladino.PluginBuiltIns().Functions["hasFilePattern"].ValidateArg
+
+	assert.NoError(t, validateArg(0, "src/**/*.go"))
+	assert.EqualError(t, validateArg(0, "[0-9"), "invalid pattern \"[0-9\"")
+}
+
 func TestHasFilePattern_WhenTrue(t *testing.T) {
 	defaultMockPrFileName := "default-mock-repo/file1.ts"
 	mockedPullRequestFileList := &[]*github.CommitFile{
@@ -14,6 +14,8 @@ func HasLinkedIssues() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
 		Code: hasLinkedIssuesCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
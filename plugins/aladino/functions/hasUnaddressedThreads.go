@@ -13,6 +13,8 @@ func HasUnaddressedThreads() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
 		Code: hasUnaddressedThreadsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
@@ -0,0 +1,45 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"fmt"
+	"time"
+	// the time zone database is embedded so that time zones are available on hosts without one
+	_ "time/tzdata"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func HourOf() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionTypeWithDefaults(
+			[]aladino.Type{aladino.BuildTimestampType(), aladino.BuildStringType()},
+			[]aladino.Value{aladino.BuildStringValue("UTC")},
+			aladino.BuildIntType(),
+		).WithParamNames("time", "tz"),
+		Code: hourOfCode,
+	}
+}
+
+// hourOfCode returns the hour of a timestamp in the given time zone, between 0 and 23.
+func hourOfCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	t, err := timeIn(args[0].(*aladino.TimeValue).Val, args[1].(*aladino.StringValue).Val)
+	if err != nil {
+		return nil, err
+	}
+
+	return aladino.BuildIntValue(t.Hour()), nil
+}
+
+// timeIn converts a timestamp to a time in the time zone with the given IANA name, e.g. "Europe/Lisbon".
+func timeIn(timestamp int, tz string) (time.Time, error) {
+	location, err := time.LoadLocation(tz)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown time zone %v", tz)
+	}
+
+	return time.Unix(int64(timestamp), 0).In(location), nil
+}
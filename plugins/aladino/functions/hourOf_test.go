@@ -0,0 +1,48 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var hourOf = plugins_aladino.PluginBuiltIns().Functions["hourOf"].Code
+
+func TestHourOf(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	date := time.Date(2022, 10, 1, 17, 45, 0, 0, time.UTC)
+
+	args := []aladino.Value{aladino.BuildTimeValue(int(date.Unix())), aladino.BuildStringValue("UTC")}
+	gotHour, err := hourOf(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(17), gotHour)
+}
+
+func TestHourOf_WhenTimezoneIsGiven(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	// Lisbon is at UTC+01:00 in summer time
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$hourOf(2022-10-01T17:45:00Z, tz: "Europe/Lisbon") == 18`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestHourOf_WhenTimezoneIsUnknown(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{aladino.BuildTimeValue(0), aladino.BuildStringValue("Europe/Nowhere")}
+	gotHour, err := hourOf(mockedEnv, args)
+
+	assert.Nil(t, gotHour)
+	assert.EqualError(t, err, "unknown time zone Europe/Nowhere")
+}
@@ -8,13 +8,14 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func IsElementOf() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildBoolType()),
+		// (a, []a) => Bool
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildTypeVariable("a"), aladino.BuildArrayOfType(aladino.BuildTypeVariable("a"))}, aladino.BuildBoolType()).WithParamNames("elem", "list"),
 		Code: isElementOfCode,
 	}
 }
 
 func isElementOfCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
-	member := args[0].(*aladino.StringValue)
+	member := args[0]
 	group := args[1].(*aladino.ArrayValue).Vals
 
 	for _, groupMember := range group {
@@ -49,3 +49,21 @@ func TestIsElementOf_WhenFalse(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantVal, gotVal)
 }
+
+func TestIsElementOf_WhenElementsAreInts(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$isElementOf(2, [1, 2, 3]) && !$isElementOf(4, [1, 2, 3])`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestIsElementOf_WhenElementTypeDiffersFromArray(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$isElementOf("2", [1, 2, 3])`)
+
+	assert.False(t, gotVal)
+	assert.EqualError(t, err, "type error at line 1, column 19: type inference failed: mismatch in arg types on isElementOf: wrong type for parameter list\n    $isElementOf(\"2\", [1, 2, 3])\n                      ^")
+}
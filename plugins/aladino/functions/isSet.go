@@ -0,0 +1,21 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func IsSet() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (Optional[a]) => Bool
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildOptionalType(aladino.BuildTypeVariable("a"))}, aladino.BuildBoolType()).WithParamNames("value"),
+		Code: isSetCode,
+	}
+}
+
+func isSetCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	return aladino.BuildBoolValue(args[0].(*aladino.OptionalValue).IsSet()), nil
+}
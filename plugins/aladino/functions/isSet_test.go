@@ -0,0 +1,42 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var isSet = plugins_aladino.PluginBuiltIns().Functions["isSet"].Code
+
+func TestIsSet(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		value   aladino.Value
+		wantVal aladino.Value
+	}{
+		"when value is absent": {
+			value:   aladino.BuildNoneValue(),
+			wantVal: aladino.BuildBoolValue(false),
+		},
+		"when value is present": {
+			value:   aladino.BuildSomeValue(aladino.BuildStringValue("")),
+			wantVal: aladino.BuildBoolValue(true),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotVal, err := isSet(mockedEnv, []aladino.Value{test.value})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
@@ -16,6 +16,8 @@ func IsWaitingForReview() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
 		Code: isWaitingForReviewCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
@@ -33,7 +35,7 @@ func isWaitingForReviewCode(e aladino.Env, _ []aladino.Value) (aladino.Value, er
 	repo := gh.GetPullRequestBaseRepoName(pullRequest)
 	author := pullRequest.GetUser().GetLogin()
 
-	commits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, prNum)
+	commits, err := aladino.GetPullRequestCommits(e)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +53,7 @@ func isWaitingForReviewCode(e aladino.Env, _ []aladino.Value) (aladino.Value, er
 	}
 	lastUpdateDate := *lastCommit.Commit.Committer.Date
 
-	reviews, err := e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, prNum)
+	reviews, err := aladino.GetPullRequestReviews(e)
 	if err != nil {
 		return nil, err
 	}
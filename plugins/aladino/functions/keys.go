@@ -0,0 +1,45 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"sort"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Keys() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (map[String]a) => []String
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{aladino.BuildMapType(aladino.BuildTypeVariable("a"))},
+			aladino.BuildArrayOfType(aladino.BuildStringType()),
+		).WithParamNames("map"),
+		Code: keysCode,
+	}
+}
+
+func keysCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	vals := args[0].(*aladino.MapValue).Vals
+
+	keys := make([]aladino.Value, 0, len(vals))
+	for _, key := range sortedKeys(vals) {
+		keys = append(keys, aladino.BuildStringValue(key))
+	}
+
+	return aladino.BuildArrayValue(keys), nil
+}
+
+// sortedKeys returns the keys of a map in alphabetical order
+// so that the results of the built-ins on maps do not change between runs.
+func sortedKeys(vals map[string]aladino.Value) []string {
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var keys = plugins_aladino.PluginBuiltIns().Functions["keys"].Code
+
+func TestKeys(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{
+			"web/": aladino.BuildIntValue(1),
+			"api/": aladino.BuildIntValue(2),
+		}),
+	}
+	gotKeys, err := keys(mockedEnv, args)
+
+	wantKeys := aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("api/"), aladino.BuildStringValue("web/")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantKeys, gotKeys)
+}
+
+func TestKeys_WhenMapIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{aladino.BuildMapValue(map[string]aladino.Value{})}
+	gotKeys, err := keys(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildArrayValue([]aladino.Value{}), gotKeys)
+}
@@ -11,8 +11,10 @@ import (
 
 func LastEventAt() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildIntType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildTimestampType()),
 		Code: lastEventAtCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
@@ -35,5 +37,5 @@ func lastEventAtCode(e aladino.Env, args []aladino.Value) (aladino.Value, error)
 		lastEventTime = int(lastEvent.GetCreatedAt().Unix())
 	}
 
-	return aladino.BuildIntValue(lastEventTime), nil
+	return aladino.BuildTimeValue(lastEventTime), nil
 }
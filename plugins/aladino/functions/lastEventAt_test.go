@@ -52,7 +52,7 @@ func TestLastEvent(t *testing.T) {
 
 	tests := map[string]struct {
 		mockedEnv aladino.Env
-		wantVal   *aladino.IntValue
+		wantVal   *aladino.TimeValue
 	}{
 		"when last event is not a review": {
 			mockedEnv: aladino.MockDefaultEnv(
@@ -78,7 +78,7 @@ func TestLastEvent(t *testing.T) {
 				aladino.MockBuiltIns(),
 				nil,
 			),
-			wantVal: aladino.BuildIntValue(int(lastEventDate.Unix())),
+			wantVal: aladino.BuildTimeValue(int(lastEventDate.Unix())),
 		},
 		"when last event is a review": {
 			mockedEnv: aladino.MockDefaultEnv(
@@ -104,7 +104,7 @@ func TestLastEvent(t *testing.T) {
 				aladino.MockBuiltIns(),
 				nil,
 			),
-			wantVal: aladino.BuildIntValue(int(lastEventDate.Unix())),
+			wantVal: aladino.BuildTimeValue(int(lastEventDate.Unix())),
 		},
 	}
 
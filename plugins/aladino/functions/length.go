@@ -10,7 +10,8 @@ import (
 
 func Length() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildIntType()),
+		// ([]a) => Int
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildTypeVariable("a"))}, aladino.BuildIntType()).WithParamNames("list"),
 		Code: lengthCode,
 	}
 }
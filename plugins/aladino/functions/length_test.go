@@ -32,3 +32,12 @@ func TestLength(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantLength, gotLength)
 }
+
+func TestLength_WhenArrayOfInts(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$length($filter([1, 2, 3], ($n: Int => $n > 1))) == 2`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -0,0 +1,41 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Map() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// ([]a, (a) => b) => []b
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+				aladino.BuildFunctionType(
+					[]aladino.Type{aladino.BuildTypeVariable("a")},
+					aladino.BuildTypeVariable("b"),
+				),
+			},
+			aladino.BuildArrayOfType(aladino.BuildTypeVariable("b")),
+		).WithParamNames("list", "transform"),
+		Code: mapCode,
+	}
+}
+
+func mapCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	elems := args[0].(*aladino.ArrayValue).Vals
+	fn := args[1].(*aladino.FunctionValue).Fn
+
+	result := make([]aladino.Value, len(elems))
+	for i, elem := range elems {
+		fnResult, err := fn([]aladino.Value{elem})
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = fnResult
+	}
+
+	return aladino.BuildArrayValue(result), nil
+}
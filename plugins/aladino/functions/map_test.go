@@ -0,0 +1,48 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var mapFn = plugins_aladino.PluginBuiltIns().Functions["map"].Code
+
+func TestMap(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a"), aladino.BuildStringValue("b")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildStringValue("docs/" + args[0].(*aladino.StringValue).Val), nil
+		}),
+	}
+	gotElems, err := mapFn(mockedEnv, args)
+
+	wantElems := aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("docs/a"), aladino.BuildStringValue("docs/b")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantElems, gotElems)
+}
+
+func TestMap_WhenFunctionFails(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return nil, fmt.Errorf("failure")
+		}),
+	}
+	gotElems, err := mapFn(mockedEnv, args)
+
+	assert.Nil(t, gotElems)
+	assert.EqualError(t, err, "failure")
+}
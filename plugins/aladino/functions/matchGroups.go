@@ -0,0 +1,36 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func MatchGroups() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{aladino.BuildStringType(), aladino.BuildRegexType()},
+			aladino.BuildArrayOfType(aladino.BuildStringType()),
+		).WithParamNames("text", "regex"),
+		Code: matchGroupsCode,
+	}
+}
+
+// matchGroupsCode returns the text captured by each group of the regular expression
+// in its first match in the string. When there is no match, the result is empty.
+func matchGroupsCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	str := args[0].(*aladino.StringValue).Val
+	regex := args[1].(*aladino.RegexValue).Val
+
+	match := regex.FindStringSubmatch(str)
+	if match == nil {
+		return aladino.BuildArrayValue([]aladino.Value{}), nil
+	}
+
+	groups := make([]aladino.Value, len(match)-1)
+	for i, group := range match[1:] {
+		groups[i] = aladino.BuildStringValue(group)
+	}
+
+	return aladino.BuildArrayValue(groups), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var matchGroups = plugins_aladino.PluginBuiltIns().Functions["matchGroups"].Code
+
+func TestMatchGroups(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildStringValue("feat(api): add endpoint"),
+		aladino.BuildRegexValue(regexp.MustCompile(`^(\w+)\((\w+)\):`)),
+	}
+	gotGroups, err := matchGroups(mockedEnv, args)
+
+	wantGroups := aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("feat"), aladino.BuildStringValue("api")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantGroups, gotGroups)
+}
+
+func TestMatchGroups_WhenNoMatch(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildStringValue("add endpoint"),
+		aladino.BuildRegexValue(regexp.MustCompile(`^(\w+)\((\w+)\):`)),
+	}
+	gotGroups, err := matchGroups(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildArrayValue([]aladino.Value{}), gotGroups)
+}
+
+func TestMatchGroups_WhenRegexIsLiteral(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$matchGroups("fix(web): typo", r"^(\w+)\((\w+)\)") == ["fix", "web"]`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -8,12 +8,16 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func Milestone() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildStringType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildOptionalType(aladino.BuildStringType())),
 		Code: milestoneCode,
 	}
 }
 
 func milestoneCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
-	milestoneTitle := e.GetPullRequest().GetMilestone().GetTitle()
-	return aladino.BuildStringValue(milestoneTitle), nil
+	milestone := e.GetPullRequest().GetMilestone()
+	if milestone == nil {
+		return aladino.BuildNoneValue(), nil
+	}
+
+	return aladino.BuildSomeValue(aladino.BuildStringValue(milestone.GetTitle())), nil
 }
@@ -42,8 +42,33 @@ func TestMilestone(t *testing.T) {
 	args := []aladino.Value{}
 	gotMilestoneTitle, err := milestone(mockedEnv, args)
 
-	wantMilestoneTitle := aladino.BuildStringValue(milestoneTitle)
+	wantMilestoneTitle := aladino.BuildSomeValue(aladino.BuildStringValue(milestoneTitle))
 
 	assert.Nil(t, err)
 	assert.Equal(t, wantMilestoneTitle, gotMilestoneTitle)
 }
+
+func TestMilestone_WhenPullRequestHasNoMilestone(t *testing.T) {
+	mockedPullRequest := aladino.GetDefaultMockPullRequestDetailsWith(&github.PullRequest{})
+	mockedPullRequest.Milestone = nil
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Write(mock.MustMarshal(mockedPullRequest))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []aladino.Value{}
+	gotMilestoneTitle, err := milestone(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildNoneValue(), gotMilestoneTitle)
+}
@@ -0,0 +1,21 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func None() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// () => Optional[a]
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildOptionalType(aladino.BuildTypeVariable("a"))),
+		Code: noneCode,
+	}
+}
+
+func noneCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
+	return aladino.BuildNoneValue(), nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var none = plugins_aladino.PluginBuiltIns().Functions["none"].Code
+
+func TestNone(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	gotVal, err := none(mockedEnv, []aladino.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildNoneValue(), gotVal)
+}
+
+func TestNone_WhenComparedWithOptionalValue(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$none() != $some("v1.0") && $some(1) == $some(1)`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -0,0 +1,22 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Now() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildTimestampType()),
+		Code: nowCode,
+	}
+}
+
+func nowCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
+	return aladino.BuildTimeValue(int(time.Now().Unix())), nil
+}
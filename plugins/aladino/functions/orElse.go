@@ -0,0 +1,29 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func OrElse() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (Optional[a], a) => a
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{aladino.BuildOptionalType(aladino.BuildTypeVariable("a")), aladino.BuildTypeVariable("a")},
+			aladino.BuildTypeVariable("a"),
+		).WithParamNames("value", "default"),
+		Code: orElseCode,
+	}
+}
+
+func orElseCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	optional := args[0].(*aladino.OptionalValue)
+	if !optional.IsSet() {
+		return args[1], nil
+	}
+
+	return optional.Val, nil
+}
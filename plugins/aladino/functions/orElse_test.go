@@ -0,0 +1,51 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var orElse = plugins_aladino.PluginBuiltIns().Functions["orElse"].Code
+
+func TestOrElse(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	tests := map[string]struct {
+		value   aladino.Value
+		wantVal aladino.Value
+	}{
+		"when value is absent": {
+			value:   aladino.BuildNoneValue(),
+			wantVal: aladino.BuildStringValue("default"),
+		},
+		"when value is present": {
+			value:   aladino.BuildSomeValue(aladino.BuildStringValue("v1.0")),
+			wantVal: aladino.BuildStringValue("v1.0"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			args := []aladino.Value{test.value, aladino.BuildStringValue("default")}
+			gotVal, err := orElse(mockedEnv, args)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestOrElse_WhenTypesDiffer(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	_, err := aladino.EvalExpr(mockedEnv, "patch", `$orElse($some("v1.0"), 1) == 1`)
+
+	assert.EqualError(t, err, "type error at line 1, column 24: type inference failed: mismatch in arg types on orElse: wrong type for parameter default\n    $orElse($some(\"v1.0\"), 1) == 1\n                           ^")
+}
@@ -10,6 +10,8 @@ func Organization() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: organizationCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
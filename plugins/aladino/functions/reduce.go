@@ -0,0 +1,42 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Reduce() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// ([]a, b, (b, a) => b) => b
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+				aladino.BuildTypeVariable("b"),
+				aladino.BuildFunctionType(
+					[]aladino.Type{aladino.BuildTypeVariable("b"), aladino.BuildTypeVariable("a")},
+					aladino.BuildTypeVariable("b"),
+				),
+			},
+			aladino.BuildTypeVariable("b"),
+		).WithParamNames("list", "initial", "combine"),
+		Code: reduceCode,
+	}
+}
+
+func reduceCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	elems := args[0].(*aladino.ArrayValue).Vals
+	acc := args[1]
+	fn := args[2].(*aladino.FunctionValue).Fn
+
+	for _, elem := range elems {
+		fnResult, err := fn([]aladino.Value{acc, elem})
+		if err != nil {
+			return nil, err
+		}
+
+		acc = fnResult
+	}
+
+	return acc, nil
+}
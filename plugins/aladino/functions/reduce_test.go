@@ -0,0 +1,44 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var reduce = plugins_aladino.PluginBuiltIns().Functions["reduce"].Code
+
+func TestReduce(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("ab"), aladino.BuildStringValue("cde")}),
+		aladino.BuildIntValue(1),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			acc := args[0].(*aladino.IntValue).Val
+			elem := args[1].(*aladino.StringValue).Val
+			return aladino.BuildIntValue(acc + len(elem)), nil
+		}),
+	}
+	gotVal, err := reduce(mockedEnv, args)
+
+	wantVal := aladino.BuildIntValue(6)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestReduce_WhenWrittenWithLambda(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$reduce($map([1, 2, 3], ($n: Int => $n * 2)), 0, ($sum: Int, $n: Int => $sum + $n)) == 12`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
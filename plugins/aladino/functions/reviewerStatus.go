@@ -4,27 +4,21 @@
 
 package plugins_aladino_functions
 
-import (
-	gh "github.com/reviewpad/reviewpad/v3/codehost/github"
-	"github.com/reviewpad/reviewpad/v3/lang/aladino"
-)
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func ReviewerStatus() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildOptionalType(aladino.BuildStringType())).WithParamNames("reviewer"),
 		Code: reviewerStatusCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
 func reviewerStatusCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 	reviewerLogin := args[0].(*aladino.StringValue)
 
-	pullRequest := e.GetPullRequest()
-	prNum := gh.GetPullRequestNumber(pullRequest)
-	owner := gh.GetPullRequestBaseOwnerName(pullRequest)
-	repo := gh.GetPullRequestBaseRepoName(pullRequest)
-
-	reviews, err := e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, prNum)
+	reviews, err := aladino.GetPullRequestReviews(e)
 	if err != nil {
 		return nil, err
 	}
@@ -54,5 +48,10 @@ func reviewerStatusCode(e aladino.Env, args []aladino.Value) (aladino.Value, err
 		}
 	}
 
-	return aladino.BuildStringValue(status), nil
+	// The reviewer has not reviewed the pull request.
+	if status == "" {
+		return aladino.BuildNoneValue(), nil
+	}
+
+	return aladino.BuildSomeValue(aladino.BuildStringValue(status)), nil
 }
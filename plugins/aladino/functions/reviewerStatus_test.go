@@ -64,7 +64,7 @@ func TestReviewerStatus_WhenUserIsNil(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("")
+	wantReviewState := aladino.BuildNoneValue()
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -94,7 +94,7 @@ func TestReviewerStatus_WhenStateIsNil(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("")
+	wantReviewState := aladino.BuildNoneValue()
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -125,7 +125,7 @@ func TestReviewerStatus_WhenStateUnknown(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("")
+	wantReviewState := aladino.BuildNoneValue()
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -162,7 +162,7 @@ func TestReviewerStatus_WhenStateCommented(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("COMMENTED")
+	wantReviewState := aladino.BuildSomeValue(aladino.BuildStringValue("COMMENTED"))
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -217,7 +217,7 @@ func TestReviewerStatus_WhenStateApproved(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("APPROVED")
+	wantReviewState := aladino.BuildSomeValue(aladino.BuildStringValue("APPROVED"))
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -266,7 +266,7 @@ func TestReviewerStatus_WhenStateRequestedChanges(t *testing.T) {
 		nil,
 	)
 
-	wantReviewState := aladino.BuildStringValue("CHANGES_REQUESTED")
+	wantReviewState := aladino.BuildSomeValue(aladino.BuildStringValue("CHANGES_REQUESTED"))
 
 	args := []aladino.Value{aladino.BuildStringValue("mary")}
 	gotReviewState, err := reviewerStatus(mockedEnv, args)
@@ -10,6 +10,7 @@ func Reviewers() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: reviewersCode,
+		Pure: true,
 	}
 }
 
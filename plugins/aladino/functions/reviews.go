@@ -0,0 +1,34 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Reviews() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildReviewType())),
+		Code: reviewsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
+	}
+}
+
+func reviewsCode(e aladino.Env, _ []aladino.Value) (aladino.Value, error) {
+	ghReviews, err := aladino.GetPullRequestReviews(e)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]aladino.Value, len(ghReviews))
+	for i, ghReview := range ghReviews {
+		reviews[i] = aladino.BuildRecordValue(map[string]aladino.Value{
+			"user":        aladino.BuildStringValue(ghReview.GetUser().GetLogin()),
+			"state":       aladino.BuildStringValue(ghReview.GetState()),
+			"submittedAt": aladino.BuildTimeValue(int(ghReview.GetSubmittedAt().Unix())),
+		})
+	}
+
+	return aladino.BuildArrayValue(reviews), nil
+}
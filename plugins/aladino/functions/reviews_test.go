@@ -0,0 +1,94 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v45/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var reviews = plugins_aladino.PluginBuiltIns().Functions["reviews"].Code
+
+func TestReviews_WhenListReviewsRequestFails(t *testing.T) {
+	failMessage := "ListReviewsRequestFail"
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(
+						w,
+						http.StatusInternalServerError,
+						failMessage,
+					)
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []aladino.Value{}
+	gotReviews, err := reviews(mockedEnv, args)
+
+	assert.Nil(t, gotReviews)
+	assert.Equal(t, err.(*github.ErrorResponse).Message, failMessage)
+}
+
+func TestReviews(t *testing.T) {
+	submittedAt := time.Date(2022, 10, 3, 12, 0, 0, 0, time.UTC)
+	ghReviews := []*github.PullRequestReview{
+		{
+			User:        &github.User{Login: github.String("john")},
+			State:       github.String("APPROVED"),
+			SubmittedAt: &submittedAt,
+		},
+		{
+			User:        &github.User{Login: github.String("jane")},
+			State:       github.String("CHANGES_REQUESTED"),
+			SubmittedAt: &submittedAt,
+		},
+	}
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+				ghReviews,
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	wantReviews := aladino.BuildArrayValue([]aladino.Value{
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"user":        aladino.BuildStringValue("john"),
+			"state":       aladino.BuildStringValue("APPROVED"),
+			"submittedAt": aladino.BuildTimeValue(int(submittedAt.Unix())),
+		}),
+		aladino.BuildRecordValue(map[string]aladino.Value{
+			"user":        aladino.BuildStringValue("jane"),
+			"state":       aladino.BuildStringValue("CHANGES_REQUESTED"),
+			"submittedAt": aladino.BuildTimeValue(int(submittedAt.Unix())),
+		}),
+	})
+
+	args := []aladino.Value{}
+	gotReviews, err := reviews(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantReviews, gotReviews)
+}
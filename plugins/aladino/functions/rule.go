@@ -12,8 +12,9 @@ import (
 
 func Rule() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildBoolType()).WithParamNames("name"),
 		Code: ruleCode,
+		Cost: aladino.COST_HIGH,
 	}
 }
 
@@ -37,7 +37,7 @@ func TestRule_WhenRuleIsInvalid(t *testing.T) {
 	gotVal, err := rule(mockedEnv, args)
 
 	assert.Nil(t, gotVal)
-	assert.EqualError(t, err, "type inference failed")
+	assert.EqualError(t, err, "type error at line 1, column 3: type inference failed\n    1 == \"a\"\n      ^")
 }
 
 func TestRule_WhenRuleIsTrue(t *testing.T) {
@@ -0,0 +1,21 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Some() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (a) => Optional[a]
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildTypeVariable("a")}, aladino.BuildOptionalType(aladino.BuildTypeVariable("a"))).WithParamNames("value"),
+		Code: someCode,
+	}
+}
+
+func someCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	return aladino.BuildSomeValue(args[0]), nil
+}
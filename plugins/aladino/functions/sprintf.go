@@ -6,25 +6,45 @@ package plugins_aladino_functions
 
 import (
 	"fmt"
+
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 )
 
 func Sprintf() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildStringType()),
+		Type: aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildTypeVariable("a"), aladino.BuildStringType()).WithParamNames("format"),
 		Code: sprintfCode,
 	}
 }
 
+// sprintfCode formats the values that follow the format.
+// Strings, integers and booleans are given to the format as they are, so that verbs such as %d or %t apply to them,
+// while any other value is written as it is in the language, e.g. [1, 2] or 2022-10-01T00:00:00Z.
+//
+// For compatibility with $sprintf("Hello %v!", ["world"]), a single array given as the only value is spread:
+// each of its elements is a value of the format. An array given with other values, or nested in another array
+// as in $sprintf("%v", [["a", "b"]]), is a value of its own.
 func sprintfCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
-	var clearVals []interface{}
 	format := args[0].(*aladino.StringValue).Val
 	vals := args[1].(*aladino.ArrayValue).Vals
 
-	for _, val := range vals {
-		switch val.(type) {
+	if len(vals) == 1 {
+		if arrayVal, ok := vals[0].(*aladino.ArrayValue); ok {
+			vals = arrayVal.Vals
+		}
+	}
+
+	clearVals := make([]interface{}, len(vals))
+	for i, val := range vals {
+		switch v := val.(type) {
 		case *aladino.StringValue:
-			clearVals = append(clearVals, val.(*aladino.StringValue).Val)
+			clearVals[i] = v.Val
+		case *aladino.IntValue:
+			clearVals[i] = v.Val
+		case *aladino.BoolValue:
+			clearVals[i] = v.Val
+		default:
+			clearVals[i] = aladino.FormatValue(v)
 		}
 	}
 
@@ -5,7 +5,9 @@
 package plugins_aladino_functions_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
@@ -32,3 +34,76 @@ func TestSprintf(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantString, gotString)
 }
+
+func TestSprintf_WhenManyValuesAreGiven(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	format := aladino.BuildStringValue("%v has %v labels: %v")
+	vals := aladino.BuildArrayValue(
+		[]aladino.Value{
+			aladino.BuildStringValue("#1"),
+			aladino.BuildIntValue(2),
+			aladino.BuildBoolValue(true),
+		},
+	)
+	args := []aladino.Value{format, vals}
+
+	wantString := &aladino.StringValue{Val: "#1 has 2 labels: true"}
+
+	gotString, err := sprintf(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantString, gotString)
+}
+
+func TestSprintf_WhenValuesAreNotBasic(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	format := aladino.BuildStringValue("%v is due %v, in %v")
+	vals := aladino.BuildArrayValue(
+		[]aladino.Value{
+			aladino.BuildSomeValue(aladino.BuildStringValue("review")),
+			aladino.BuildTimeValue(int(time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC).Unix())),
+			aladino.BuildDurationValue(2 * 60 * 60),
+		},
+	)
+	args := []aladino.Value{format, vals}
+
+	wantString := &aladino.StringValue{Val: `some("review") is due 2022-10-01T00:00:00Z, in 2 hours`}
+
+	gotString, err := sprintf(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantString, gotString)
+}
+
+func TestSprintf_WhenValuesHaveArrays(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	tests := map[string]struct {
+		expr       string
+		wantString string
+	}{
+		"when the only value is an array": {
+			expr:       `$sprintf("%v and %v", ["a", "b"])`,
+			wantString: "a and b",
+		},
+		"when an array is given with other values": {
+			expr:       `$sprintf("%v: %v", "labels", ["a", "b"])`,
+			wantString: `labels: ["a", "b"]`,
+		},
+		"when the only value is a nested array": {
+			expr:       `$sprintf("%v", [["a", "b"]])`,
+			wantString: `["a", "b"]`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotVal, err := aladino.EvalExpr(mockedEnv, "patch", fmt.Sprintf("%v == %q", test.expr, test.wantString))
+
+			assert.Nil(t, err)
+			assert.True(t, gotVal, test.expr)
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 
 func Team() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildArrayOfType(aladino.BuildStringType())),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildArrayOfType(aladino.BuildStringType())).WithParamNames("slug"),
 		Code: teamCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
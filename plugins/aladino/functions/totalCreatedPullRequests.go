@@ -12,8 +12,10 @@ import (
 
 func TotalCreatedPullRequests() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildIntType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildIntType()).WithParamNames("author"),
 		Code: totalCreatedPullRequestsCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
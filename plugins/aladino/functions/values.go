@@ -0,0 +1,30 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import "github.com/reviewpad/reviewpad/v3/lang/aladino"
+
+func Values() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		// (map[String]a) => []a
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{aladino.BuildMapType(aladino.BuildTypeVariable("a"))},
+			aladino.BuildArrayOfType(aladino.BuildTypeVariable("a")),
+		).WithParamNames("map"),
+		Code: valuesCode,
+	}
+}
+
+func valuesCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	vals := args[0].(*aladino.MapValue).Vals
+
+	// the values are in the order of their keys
+	values := make([]aladino.Value, 0, len(vals))
+	for _, key := range sortedKeys(vals) {
+		values = append(values, vals[key])
+	}
+
+	return aladino.BuildArrayValue(values), nil
+}
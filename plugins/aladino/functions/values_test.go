@@ -0,0 +1,32 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var values = plugins_aladino.PluginBuiltIns().Functions["values"].Code
+
+func TestValues(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{
+			"web/": aladino.BuildIntValue(1),
+			"api/": aladino.BuildIntValue(2),
+		}),
+	}
+	gotValues, err := values(mockedEnv, args)
+
+	wantValues := aladino.BuildArrayValue([]aladino.Value{aladino.BuildIntValue(2), aladino.BuildIntValue(1)})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantValues, gotValues)
+}
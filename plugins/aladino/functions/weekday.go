@@ -0,0 +1,30 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Weekday() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionTypeWithDefaults(
+			[]aladino.Type{aladino.BuildTimestampType(), aladino.BuildStringType()},
+			[]aladino.Value{aladino.BuildStringValue("UTC")},
+			aladino.BuildStringType(),
+		).WithParamNames("time", "tz"),
+		Code: weekdayCode,
+	}
+}
+
+// weekdayCode returns the day of the week of a timestamp in the given time zone, e.g. "Saturday".
+func weekdayCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	t, err := timeIn(args[0].(*aladino.TimeValue).Val, args[1].(*aladino.StringValue).Val)
+	if err != nil {
+		return nil, err
+	}
+
+	return aladino.BuildStringValue(t.Weekday().String()), nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var weekday = plugins_aladino.PluginBuiltIns().Functions["weekday"].Code
+
+func TestWeekday(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	date := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	args := []aladino.Value{aladino.BuildTimeValue(int(date.Unix())), aladino.BuildStringValue("UTC")}
+	gotWeekday, err := weekday(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildStringValue("Saturday"), gotWeekday)
+}
+
+func TestWeekday_WhenTimestampHasTimezone(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	// 23:30 on Friday at an offset of -01:00 is already Saturday in UTC
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$weekday(2022-09-30T23:30:00-01:00) == "Saturday"`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestWeekday_WhenTimezoneIsGiven(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, plugins_aladino.PluginBuiltIns(), nil)
+
+	// 23:30 on Friday in UTC is already Saturday in Tokyo
+	gotVal, err := aladino.EvalExpr(mockedEnv, "patch", `$weekday(2022-09-30T23:30:00Z, tz: "Asia/Tokyo") == "Saturday"`)
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
@@ -15,8 +15,10 @@ import (
 
 func WorkflowStatus() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildOptionalType(aladino.BuildStringType())).WithParamNames("name"),
 		Code: workflowStatusCode,
+		Cost: aladino.COST_MEDIUM,
+		Pure: true,
 	}
 }
 
@@ -25,12 +27,12 @@ func workflowStatusCode(e aladino.Env, args []aladino.Value) (aladino.Value, err
 
 	workflowPayload := e.GetEventPayload()
 	if reflect.TypeOf(workflowPayload).String() != "*github.WorkflowRunEvent" {
-		return aladino.BuildStringValue(""), nil
+		return aladino.BuildNoneValue(), nil
 	}
 
 	workflowRunPayload := workflowPayload.(*github.WorkflowRunEvent).WorkflowRun
 	if workflowRunPayload == nil {
-		return aladino.BuildStringValue(""), nil
+		return aladino.BuildNoneValue(), nil
 	}
 
 	headSHA := workflowRunPayload.GetHeadSHA()
@@ -46,12 +48,12 @@ func workflowStatusCode(e aladino.Env, args []aladino.Value) (aladino.Value, err
 	for _, check := range checkRuns.CheckRuns {
 		if *check.Name == workflowName {
 			if *check.Status == "completed" {
-				return aladino.BuildStringValue(*check.Conclusion), nil
+				return aladino.BuildSomeValue(aladino.BuildStringValue(*check.Conclusion)), nil
 			} else {
-				return aladino.BuildStringValue(*check.Status), nil
+				return aladino.BuildSomeValue(aladino.BuildStringValue(*check.Status)), nil
 			}
 		}
 	}
 
-	return aladino.BuildStringValue(""), nil
+	return aladino.BuildNoneValue(), nil
 }
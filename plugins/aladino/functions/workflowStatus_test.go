@@ -19,7 +19,7 @@ var workflowStatus = plugins_aladino.PluginBuiltIns().Functions["workflowStatus"
 
 func TestWorkflowStatus_WhenEventPayloadIsNotWorkflowRunEvent(t *testing.T) {
 	checkName := "test-workflow"
-	wantValue := aladino.BuildStringValue("")
+	wantValue := aladino.BuildNoneValue()
 
 	eventPayload := &github.CheckRunEvent{}
 	mockedEnv := aladino.MockDefaultEnv(
@@ -39,7 +39,7 @@ func TestWorkflowStatus_WhenEventPayloadIsNotWorkflowRunEvent(t *testing.T) {
 
 func TestWorkflowStatus_WhenWorkflowRunIsNil(t *testing.T) {
 	checkName := "test-workflow"
-	wantValue := aladino.BuildStringValue("")
+	wantValue := aladino.BuildNoneValue()
 
 	eventPayload := &github.WorkflowRunEvent{
 		WorkflowRun: nil,
@@ -99,7 +99,7 @@ func TestWorkflowStatus_WhenCheckRunNotFoundDueToEmptyCheckRuns(t *testing.T) {
 	checkName := "test-workflow"
 	headSHA := "1234abc"
 
-	wantValue := aladino.BuildStringValue("")
+	wantValue := aladino.BuildNoneValue()
 
 	eventPayload := &github.WorkflowRunEvent{
 		WorkflowRun: &github.WorkflowRun{
@@ -135,7 +135,7 @@ func TestWorkflowStatus_WhenCheckRunIsMissingInNonEmptyCheckRuns(t *testing.T) {
 	checkName := "test-workflow"
 	headSHA := "1234abc"
 
-	wantValue := aladino.BuildStringValue("")
+	wantValue := aladino.BuildNoneValue()
 
 	eventPayload := &github.WorkflowRunEvent{
 		WorkflowRun: &github.WorkflowRun{
@@ -178,7 +178,7 @@ func TestWorkflowStatus_WhenEventIsCompleted(t *testing.T) {
 	checkConclusion := "success"
 	headSHA := "1234abc"
 
-	wantValue := aladino.BuildStringValue(checkConclusion)
+	wantValue := aladino.BuildSomeValue(aladino.BuildStringValue(checkConclusion))
 
 	eventPayload := &github.WorkflowRunEvent{
 		WorkflowRun: &github.WorkflowRun{
@@ -225,7 +225,7 @@ func TestWorkflowStatus_WhenEventIsNotCompleted(t *testing.T) {
 	checkStatus := "in_progress"
 	headSHA := "1234abc"
 
-	wantValue := aladino.BuildStringValue(checkStatus)
+	wantValue := aladino.BuildSomeValue(aladino.BuildStringValue(checkStatus))
 
 	eventPayload := &github.WorkflowRunEvent{
 		WorkflowRun: &github.WorkflowRun{
@@ -265,3 +265,67 @@ func TestWorkflowStatus_WhenEventIsNotCompleted(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantValue, gotValue)
 }
+
+func TestWorkflowStatus_WhenComparedWithString(t *testing.T) {
+	checkName := "test-workflow"
+	checkStatus := "completed"
+	checkConclusion := "success"
+	headSHA := "1234abc"
+
+	eventPayload := &github.WorkflowRunEvent{
+		WorkflowRun: &github.WorkflowRun{
+			HeadSHA: &headSHA,
+		},
+	}
+	checkRuns := &github.ListCheckRunsResults{
+		CheckRuns: []*github.CheckRun{
+			{
+				Name:       &checkName,
+				Status:     &checkStatus,
+				Conclusion: &checkConclusion,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		expr    string
+		wantVal bool
+	}{
+		"when status is equal": {
+			expr:    `$workflowStatus("test-workflow") == "success"`,
+			wantVal: true,
+		},
+		"when status is different": {
+			expr:    `$workflowStatus("test-workflow") != "in_progress"`,
+			wantVal: true,
+		},
+		"when status is absent": {
+			expr:    `$workflowStatus("missing-workflow") == "success"`,
+			wantVal: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatchHandler(
+						mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							w.Write(mock.MustMarshal(checkRuns))
+						}),
+					),
+				},
+				nil,
+				plugins_aladino.PluginBuiltIns(),
+				eventPayload,
+			)
+
+			gotVal, err := aladino.EvalExpr(mockedEnv, "patch", test.expr)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
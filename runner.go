@@ -20,7 +20,12 @@ import (
 )
 
 func Load(buf *bytes.Buffer) (*engine.ReviewpadFile, error) {
-	file, err := engine.Load(buf.Bytes())
+	return LoadWithOptions(context.Background(), buf, engine.LoadOptions{})
+}
+
+// LoadWithOptions loads and lints a reviewpad file whose imports are resolved as configured by options.
+func LoadWithOptions(ctx context.Context, buf *bytes.Buffer, options engine.LoadOptions) (*engine.ReviewpadFile, error) {
+	file, err := engine.LoadWithOptions(ctx, buf.Bytes(), options)
 	if err != nil {
 		return nil, err
 	}